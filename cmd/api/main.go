@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/handler"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/pubsub"
 	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/security"
 )
 
@@ -34,6 +38,14 @@ func main() {
 	// Set Gin mode
 	gin.SetMode(cfg.Server.Mode)
 
+	// Manage the schema instead of serving when run as `migrate ...`
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
 	// Initialize database
 	log.Println("Connecting to database...")
 	db, err := database.NewDatabase(cfg)
@@ -48,16 +60,72 @@ func main() {
 	playerRepo := database.NewPlayerRepository(db)
 	matchRepo := database.NewMatchRepository(db)
 	goalRepo := database.NewGoalRepository(db)
+	competitionRepo := database.NewCompetitionRepository(db)
+	seasonRepo := database.NewSeasonRepository(db)
+	bracketRepo := database.NewBracketRepository(db)
+	groupRepo := database.NewGroupRepository(db)
+	matchEventRepo := database.NewMatchEventRepository(db)
+	lineupRepo := database.NewLineupRepository(db)
+	transferRepo := database.NewTransferRepository(db)
+	suspensionRepo := database.NewSuspensionRepository(db)
+	availabilityRepo := database.NewPlayerAvailabilityRepository(db)
+	ratingRepo := database.NewRatingRepository(db)
+	venueRepo := database.NewVenueRepository(db)
+	officialRepo := database.NewOfficialRepository(db)
+	transactor := database.NewTransactor(db)
 
 	// Initialize services
 	jwtService := security.NewJWTService(cfg)
+	broker := pubsub.NewMemoryBroker()
+	matchFeed := usecase.NewMatchFeed(broker, broker)
+	suspensionRules := usecase.SuspensionRules{
+		YellowCardThreshold: cfg.Discipline.YellowCardThreshold,
+		YellowCardBan:       cfg.Discipline.YellowCardBan,
+		SecondYellowBan:     cfg.Discipline.SecondYellowBan,
+		RedCardBan:          cfg.Discipline.RedCardBan,
+		Enforcement:         usecase.SuspensionEnforcement(cfg.Discipline.Enforcement),
+	}
+	if err := suspensionRules.Validate(); err != nil {
+		log.Fatalf("Invalid discipline configuration: %v", err)
+	}
+	eloSettings := usecase.EloSettings{
+		InitialRating: cfg.Rating.InitialRating,
+		KFactor:       cfg.Rating.KFactor,
+		HomeAdvantage: cfg.Rating.HomeAdvantage,
+	}
+	if err := eloSettings.Validate(); err != nil {
+		log.Fatalf("Invalid rating configuration: %v", err)
+	}
+	schedulingRules := usecase.SchedulingRules{
+		VenueSlot:  time.Duration(cfg.Scheduling.VenueSlotMinutes) * time.Minute,
+		RestWindow: time.Duration(cfg.Scheduling.RestHours) * time.Hour,
+	}
+	if err := schedulingRules.Validate(); err != nil {
+		log.Fatalf("Invalid scheduling configuration: %v", err)
+	}
 
 	// Initialize use cases
 	authUseCase := usecase.NewAuthUseCase(userRepo, jwtService)
-	teamUseCase := usecase.NewTeamUseCase(teamRepo)
-	playerUseCase := usecase.NewPlayerUseCase(playerRepo, teamRepo)
-	matchUseCase := usecase.NewMatchUseCase(matchRepo, teamRepo, playerRepo, goalRepo)
-	reportUseCase := usecase.NewReportUseCase(matchRepo, goalRepo, teamRepo)
+	teamUseCase := usecase.NewTeamUseCase(teamRepo, venueRepo)
+	playerUseCase := usecase.NewPlayerUseCase(playerRepo, teamRepo, transferRepo, transactor)
+	disciplineUseCase := usecase.NewDisciplineUseCase(suspensionRepo, matchEventRepo, matchRepo, teamRepo, suspensionRules)
+	matchScheduler := usecase.NewMatchScheduler(matchRepo, teamRepo, venueRepo, schedulingRules)
+	bracketUseCase := usecase.NewBracketUseCase(bracketRepo, matchRepo, teamRepo, seasonRepo, transactor, matchScheduler)
+	matchEventUseCase := usecase.NewMatchEventUseCase(matchEventRepo, matchRepo, playerRepo, transferRepo, goalRepo, transactor, disciplineUseCase)
+	ratingUseCase := usecase.NewRatingUseCase(ratingRepo, matchRepo, teamRepo, eloSettings)
+	matchUseCase := usecase.NewMatchUseCase(matchRepo, teamRepo, playerRepo, transferRepo, goalRepo, seasonRepo, bracketRepo, lineupRepo, venueRepo, disciplineUseCase, transactor, matchFeed, schedulingRules, ratingUseCase, bracketUseCase, matchEventUseCase, ratingUseCase)
+	liveMatchUseCase := usecase.NewLiveMatchUseCase(matchRepo, playerRepo, transferRepo, goalRepo, matchEventRepo, lineupRepo, bracketRepo, disciplineUseCase, transactor, matchFeed, bracketUseCase, matchEventUseCase, ratingUseCase)
+	reportUseCase := usecase.NewReportUseCase(matchRepo, goalRepo, teamRepo, seasonRepo)
+	competitionUseCase := usecase.NewCompetitionUseCase(competitionRepo, seasonRepo)
+	fixtureUseCase := usecase.NewFixtureUseCase(teamRepo, seasonRepo, matchScheduler, transactor)
+	groupUseCase := usecase.NewGroupUseCase(groupRepo, matchRepo, teamRepo, seasonRepo, bracketRepo, bracketUseCase, matchScheduler, transactor)
+	lineupUseCase := usecase.NewLineupUseCase(lineupRepo, matchRepo, playerRepo, transferRepo, disciplineUseCase, transactor)
+	transferUseCase := usecase.NewTransferUseCase(transferRepo, playerRepo, teamRepo, matchRepo, transactor)
+	availabilityUseCase := usecase.NewAvailabilityUseCase(availabilityRepo, playerRepo, teamRepo)
+	statsUseCase := usecase.NewStatsUseCase(playerRepo, teamRepo, goalRepo, matchRepo)
+	predictionUseCase := usecase.NewPredictionUseCase(matchRepo)
+	venueUseCase := usecase.NewVenueUseCase(venueRepo)
+	officialUseCase := usecase.NewOfficialUseCase(officialRepo, matchRepo, authUseCase, matchUseCase, transactor)
 
 	// Create default admin user
 	ctx := context.Background()
@@ -67,12 +135,34 @@ func main() {
 		log.Printf("Default admin user ensured: %s", cfg.Admin.Email)
 	}
 
+	// Replay results so ratings follow the rating settings the server
+	// starts with
+	if err := ratingUseCase.Rebuild(ctx); err != nil {
+		log.Printf("Warning: Failed to rebuild team ratings: %v", err)
+	}
+
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authUseCase)
-	teamHandler := handler.NewTeamHandler(teamUseCase)
+	teamHandler := handler.NewTeamHandler(teamUseCase, availabilityUseCase)
 	playerHandler := handler.NewPlayerHandler(playerUseCase)
 	matchHandler := handler.NewMatchHandler(matchUseCase)
 	reportHandler := handler.NewReportHandler(reportUseCase)
+	competitionHandler := handler.NewCompetitionHandler(competitionUseCase)
+	fixtureHandler := handler.NewFixtureHandler(fixtureUseCase)
+	bracketHandler := handler.NewBracketHandler(bracketUseCase)
+	groupHandler := handler.NewGroupHandler(groupUseCase)
+	matchEventHandler := handler.NewMatchEventHandler(matchEventUseCase)
+	liveMatchHandler := handler.NewLiveMatchHandler(liveMatchUseCase)
+	matchStreamHandler := handler.NewMatchStreamHandler(matchFeed, matchUseCase)
+	lineupHandler := handler.NewLineupHandler(lineupUseCase)
+	transferHandler := handler.NewTransferHandler(transferUseCase)
+	disciplineHandler := handler.NewDisciplineHandler(disciplineUseCase)
+	availabilityHandler := handler.NewAvailabilityHandler(availabilityUseCase)
+	statsHandler := handler.NewStatsHandler(statsUseCase)
+	ratingHandler := handler.NewRatingHandler(ratingUseCase)
+	predictionHandler := handler.NewPredictionHandler(predictionUseCase)
+	venueHandler := handler.NewVenueHandler(venueUseCase)
+	officialHandler := handler.NewOfficialHandler(officialUseCase)
 
 	// Initialize router
 	router := httpDelivery.NewRouter(
@@ -81,6 +171,22 @@ func main() {
 		playerHandler,
 		matchHandler,
 		reportHandler,
+		competitionHandler,
+		fixtureHandler,
+		bracketHandler,
+		groupHandler,
+		matchEventHandler,
+		liveMatchHandler,
+		matchStreamHandler,
+		lineupHandler,
+		transferHandler,
+		disciplineHandler,
+		availabilityHandler,
+		statsHandler,
+		ratingHandler,
+		predictionHandler,
+		venueHandler,
+		officialHandler,
 		jwtService,
 	)
 
@@ -112,6 +218,11 @@ func main() {
 
 	log.Println("Shutting down server...")
 
+	// Close live streams first so open connections do not hold up shutdown
+	if err := broker.Close(); err != nil {
+		log.Printf("Warning: Failed to close broker: %v", err)
+	}
+
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -122,3 +233,68 @@ func main() {
 
 	log.Println("Server exited properly")
 }
+
+// runMigrate runs `migrate up`, `migrate down [steps]` or `migrate status`
+// against the configured database
+func runMigrate(cfg *config.Config, args []string) error {
+	usage := errors.New("usage: migrate up | down [steps] | status")
+	if len(args) == 0 {
+		return usage
+	}
+
+	db, err := database.Connect(cfg)
+	if err != nil {
+		return err
+	}
+	migrator, err := database.NewMigrator(db, cfg.Database.Driver)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			log.Printf("Applied %04d_%s", migration.Version, migration.Name)
+		}
+		if err != nil {
+			return err
+		}
+		log.Printf("Schema is up to date, %d migration(s) applied", len(applied))
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps: %s", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			log.Printf("Reverted %04d_%s", migration.Version, migration.Name)
+		}
+		if err != nil {
+			return err
+		}
+		log.Printf("%d migration(s) reverted", len(reverted))
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+			if status.Missing {
+				state += " (missing from this build)"
+			}
+			fmt.Printf("%04d_%-40s %s\n", status.Version, status.Name, state)
+		}
+	default:
+		return usage
+	}
+	return nil
+}
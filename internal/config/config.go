@@ -9,10 +9,13 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Admin    AdminConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	JWT        JWTConfig
+	Admin      AdminConfig
+	Discipline DisciplineConfig
+	Rating     RatingConfig
+	Scheduling SchedulingConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -23,13 +26,14 @@ type ServerConfig struct {
 
 // DatabaseConfig holds database-related configuration
 type DatabaseConfig struct {
-	Driver   string
+	Driver   string // "postgres", "mysql" or "sqlite"
 	Host     string
 	Port     string
 	User     string
 	Password string
-	Name     string
+	Name     string // For sqlite, the database file or ":memory:"
 	SSLMode  string
+	Migrate  string // Schema mode at startup: "up", "auto" (development only) or "none"
 }
 
 // JWTConfig holds JWT-related configuration
@@ -44,12 +48,43 @@ type AdminConfig struct {
 	Password string
 }
 
+// DisciplineConfig holds the suspension rules for cards
+type DisciplineConfig struct {
+	YellowCardThreshold int    // Yellow cards that add up to a ban, 0 disables accumulation
+	YellowCardBan       int    // Matches banned for reaching the threshold
+	SecondYellowBan     int    // Matches banned for a second yellow
+	RedCardBan          int    // Matches banned for a straight red
+	Enforcement         string // "reject" or "warn" when a suspended player is named
+}
+
+// RatingConfig holds the Elo team rating settings
+type RatingConfig struct {
+	InitialRating float64 // Rating of a team before its first match
+	KFactor       float64 // Largest change a single result can make
+	HomeAdvantage float64 // Rating points added to the home side's expectation
+}
+
+// SchedulingConfig holds the rules that keep matches apart
+type SchedulingConfig struct {
+	VenueSlotMinutes int // Minutes a match occupies its venue, 0 disables the check
+	RestHours        int // Least hours between a team's kickoffs, 0 disables the check
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file if exists
 	_ = godotenv.Load()
 
 	jwtExpHours, _ := strconv.Atoi(getEnv("JWT_EXPIRATION_HOURS", "24"))
+	yellowThreshold, _ := strconv.Atoi(getEnv("DISCIPLINE_YELLOW_THRESHOLD", "5"))
+	yellowBan, _ := strconv.Atoi(getEnv("DISCIPLINE_YELLOW_BAN", "1"))
+	secondYellowBan, _ := strconv.Atoi(getEnv("DISCIPLINE_SECOND_YELLOW_BAN", "1"))
+	redCardBan, _ := strconv.Atoi(getEnv("DISCIPLINE_RED_CARD_BAN", "1"))
+	initialRating, _ := strconv.ParseFloat(getEnv("RATING_INITIAL", "1500"), 64)
+	kFactor, _ := strconv.ParseFloat(getEnv("RATING_K_FACTOR", "20"), 64)
+	homeAdvantage, _ := strconv.ParseFloat(getEnv("RATING_HOME_ADVANTAGE", "100"), 64)
+	venueSlotMinutes, _ := strconv.Atoi(getEnv("SCHEDULING_VENUE_SLOT_MINUTES", "120"))
+	restHours, _ := strconv.Atoi(getEnv("SCHEDULING_REST_HOURS", "48"))
 
 	// Railway uses PORT, fallback to SERVER_PORT
 	port := getEnv("PORT", "")
@@ -70,6 +105,7 @@ func Load() (*Config, error) {
 			Password: getEnv("DB_PASSWORD", "password"),
 			Name:     getEnv("DB_NAME", "ayo_football"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+			Migrate:  getEnv("DB_MIGRATE", "up"),
 		},
 		JWT: JWTConfig{
 			Secret:          getEnv("JWT_SECRET", "default-secret-key-change-me"),
@@ -79,6 +115,22 @@ func Load() (*Config, error) {
 			Email:    getEnv("ADMIN_EMAIL", "admin@ayofootball.com"),
 			Password: getEnv("ADMIN_PASSWORD", "Admin@123"),
 		},
+		Discipline: DisciplineConfig{
+			YellowCardThreshold: yellowThreshold,
+			YellowCardBan:       yellowBan,
+			SecondYellowBan:     secondYellowBan,
+			RedCardBan:          redCardBan,
+			Enforcement:         getEnv("DISCIPLINE_ENFORCEMENT", "reject"),
+		},
+		Rating: RatingConfig{
+			InitialRating: initialRating,
+			KFactor:       kFactor,
+			HomeAdvantage: homeAdvantage,
+		},
+		Scheduling: SchedulingConfig{
+			VenueSlotMinutes: venueSlotMinutes,
+			RestHours:        restHours,
+		},
 	}, nil
 }
 
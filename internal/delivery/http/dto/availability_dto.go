@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// CreateAvailabilityRequest represents mark player unavailable request body
+type CreateAvailabilityRequest struct {
+	Status         string `json:"status" binding:"required,oneof=injured ill unavailable"`
+	Reason         string `json:"reason" binding:"omitempty,max=500"`
+	StartDate      string `json:"start_date" binding:"required"`       // Format: 2006-01-02
+	ExpectedReturn string `json:"expected_return" binding:"omitempty"` // Format: 2006-01-02
+}
+
+// CloseAvailabilityRequest represents player return request body
+type CloseAvailabilityRequest struct {
+	EndDate string `json:"end_date" binding:"omitempty"` // Format: 2006-01-02, defaults to today
+}
+
+// PlayerAvailabilityResponse represents availability data in response
+type PlayerAvailabilityResponse struct {
+	ID             string  `json:"id"`
+	PlayerID       string  `json:"player_id"`
+	PlayerName     string  `json:"player_name,omitempty"`
+	Status         string  `json:"status"`
+	Reason         string  `json:"reason"`
+	StartDate      string  `json:"start_date"`
+	ExpectedReturn *string `json:"expected_return"`
+	EndDate        *string `json:"end_date"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// ToAvailabilityEntity converts CreateAvailabilityRequest to entity.PlayerAvailability
+func (r *CreateAvailabilityRequest) ToAvailabilityEntity(playerID uuid.UUID) (*entity.PlayerAvailability, error) {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &entity.PlayerAvailability{
+		PlayerID:  playerID,
+		Status:    entity.AvailabilityStatus(r.Status),
+		Reason:    r.Reason,
+		StartDate: startDate,
+	}
+
+	if r.ExpectedReturn != "" {
+		expectedReturn, err := time.Parse("2006-01-02", r.ExpectedReturn)
+		if err != nil {
+			return nil, err
+		}
+		availability.ExpectedReturn = &expectedReturn
+	}
+
+	return availability, nil
+}
+
+// ToEndDate parses the end date, defaulting to today
+func (r *CloseAvailabilityRequest) ToEndDate() (time.Time, error) {
+	if r.EndDate == "" {
+		return time.Now().UTC().Truncate(24 * time.Hour), nil
+	}
+	return time.Parse("2006-01-02", r.EndDate)
+}
+
+// ToPlayerAvailabilityResponse converts entity.PlayerAvailability to PlayerAvailabilityResponse
+func ToPlayerAvailabilityResponse(availability *entity.PlayerAvailability) PlayerAvailabilityResponse {
+	response := PlayerAvailabilityResponse{
+		ID:             availability.ID.String(),
+		PlayerID:       availability.PlayerID.String(),
+		Status:         string(availability.Status),
+		Reason:         availability.Reason,
+		StartDate:      availability.StartDate.Format("2006-01-02"),
+		ExpectedReturn: formatOptionalDate(availability.ExpectedReturn),
+		EndDate:        formatOptionalDate(availability.EndDate),
+		CreatedAt:      availability.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:      availability.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if availability.Player != nil {
+		response.PlayerName = availability.Player.Name
+	}
+
+	return response
+}
+
+// ToPlayerAvailabilityResponseList converts a slice of entity.PlayerAvailability to PlayerAvailabilityResponse slice
+func ToPlayerAvailabilityResponseList(availabilities []entity.PlayerAvailability) []PlayerAvailabilityResponse {
+	responses := make([]PlayerAvailabilityResponse, len(availabilities))
+	for i, availability := range availabilities {
+		responses[i] = ToPlayerAvailabilityResponse(&availability)
+	}
+	return responses
+}
@@ -0,0 +1,204 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// CreateBracketRequest represents create bracket request body
+type CreateBracketRequest struct {
+	Name              string   `json:"name" binding:"required,min=2,max=255"`
+	SeasonID          string   `json:"season_id" binding:"omitempty,uuid"`
+	TeamIDs           []string `json:"team_ids" binding:"required,min=2,dive,uuid"` // Seed order, top seed first
+	TwoLegged         bool     `json:"two_legged"`
+	TwoLeggedFinal    bool     `json:"two_legged_final"`
+	StartDate         string   `json:"start_date" binding:"required"`   // Format: 2006-01-02
+	KickoffTime       string   `json:"kickoff_time" binding:"required"` // Format: 15:04
+	RoundIntervalDays int      `json:"round_interval_days" binding:"omitempty,min=1,max=365"`
+	LegIntervalDays   int      `json:"leg_interval_days" binding:"omitempty,min=1,max=365"`
+}
+
+// BracketResponse represents bracket data in response
+type BracketResponse struct {
+	ID                string               `json:"id"`
+	SeasonID          *string              `json:"season_id"`
+	Name              string               `json:"name"`
+	Rounds            int                  `json:"rounds"`
+	TwoLegged         bool                 `json:"two_legged"`
+	TwoLeggedFinal    bool                 `json:"two_legged_final"`
+	StartDate         string               `json:"start_date"`
+	KickoffTime       string               `json:"kickoff_time"`
+	RoundIntervalDays int                  `json:"round_interval_days"`
+	LegIntervalDays   int                  `json:"leg_interval_days"`
+	Ties              []BracketTieResponse `json:"ties,omitempty"`
+	CreatedAt         string               `json:"created_at"`
+	UpdatedAt         string               `json:"updated_at"`
+}
+
+// BracketTieResponse represents a single bracket tie in response
+type BracketTieResponse struct {
+	ID            string              `json:"id"`
+	Round         int                 `json:"round"`
+	RoundName     string              `json:"round_name"`
+	Slot          int                 `json:"slot"`
+	HomeSeed      *int                `json:"home_seed"`
+	AwaySeed      *int                `json:"away_seed"`
+	HomeTeam      *TeamSimpleResponse `json:"home_team"`
+	AwayTeam      *TeamSimpleResponse `json:"away_team"`
+	TwoLegged     bool                `json:"two_legged"`
+	IsBye         bool                `json:"is_bye"`
+	FirstLeg      *MatchResponse      `json:"first_leg"`
+	SecondLeg     *MatchResponse      `json:"second_leg,omitempty"`
+	AggregateHome *int                `json:"aggregate_home"`
+	AggregateAway *int                `json:"aggregate_away"`
+	WinnerTeamID  *string             `json:"winner_team_id"`
+}
+
+// ToBracketInput converts CreateBracketRequest to usecase.BracketInput
+func (r *CreateBracketRequest) ToBracketInput() (*usecase.BracketInput, error) {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDs := make([]uuid.UUID, len(r.TeamIDs))
+	for i, id := range r.TeamIDs {
+		teamID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		teamIDs[i] = teamID
+	}
+
+	input := &usecase.BracketInput{
+		Name:              r.Name,
+		TeamIDs:           teamIDs,
+		TwoLegged:         r.TwoLegged,
+		TwoLeggedFinal:    r.TwoLeggedFinal,
+		StartDate:         startDate,
+		KickoffTime:       r.KickoffTime,
+		RoundIntervalDays: r.RoundIntervalDays,
+		LegIntervalDays:   r.LegIntervalDays,
+	}
+
+	if input.RoundIntervalDays == 0 {
+		input.RoundIntervalDays = 7
+	}
+	if input.LegIntervalDays == 0 {
+		input.LegIntervalDays = 7
+	}
+
+	if r.SeasonID != "" {
+		seasonID, err := uuid.Parse(r.SeasonID)
+		if err != nil {
+			return nil, err
+		}
+		input.SeasonID = &seasonID
+	}
+
+	return input, nil
+}
+
+// ToBracketResponse converts entity.Bracket to BracketResponse
+func ToBracketResponse(bracket *entity.Bracket) BracketResponse {
+	response := BracketResponse{
+		ID:                bracket.ID.String(),
+		Name:              bracket.Name,
+		Rounds:            bracket.Rounds,
+		TwoLegged:         bracket.TwoLegged,
+		TwoLeggedFinal:    bracket.TwoLeggedFinal,
+		StartDate:         bracket.StartDate.Format("2006-01-02"),
+		KickoffTime:       bracket.KickoffTime,
+		RoundIntervalDays: bracket.RoundIntervalDays,
+		LegIntervalDays:   bracket.LegIntervalDays,
+		CreatedAt:         bracket.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:         bracket.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if bracket.SeasonID != nil {
+		seasonID := bracket.SeasonID.String()
+		response.SeasonID = &seasonID
+	}
+
+	if bracket.Ties != nil {
+		response.Ties = make([]BracketTieResponse, len(bracket.Ties))
+		for i, tie := range bracket.Ties {
+			response.Ties[i] = ToBracketTieResponse(&tie, bracket.Rounds)
+		}
+	}
+
+	return response
+}
+
+// ToBracketResponseList converts a slice of entity.Bracket to BracketResponse slice
+func ToBracketResponseList(brackets []entity.Bracket) []BracketResponse {
+	responses := make([]BracketResponse, len(brackets))
+	for i, bracket := range brackets {
+		responses[i] = ToBracketResponse(&bracket)
+	}
+	return responses
+}
+
+// ToBracketTieResponse converts entity.BracketTie to BracketTieResponse
+func ToBracketTieResponse(tie *entity.BracketTie, rounds int) BracketTieResponse {
+	response := BracketTieResponse{
+		ID:        tie.ID.String(),
+		Round:     tie.Round,
+		RoundName: getRoundName(tie.Round, rounds),
+		Slot:      tie.Slot,
+		HomeSeed:  tie.HomeSeed,
+		AwaySeed:  tie.AwaySeed,
+		TwoLegged: tie.TwoLegged,
+		IsBye:     tie.IsBye,
+	}
+
+	if tie.HomeTeam != nil {
+		homeTeam := ToTeamSimpleResponse(tie.HomeTeam)
+		response.HomeTeam = &homeTeam
+	}
+
+	if tie.AwayTeam != nil {
+		awayTeam := ToTeamSimpleResponse(tie.AwayTeam)
+		response.AwayTeam = &awayTeam
+	}
+
+	if tie.FirstLeg != nil {
+		firstLeg := ToMatchResponse(tie.FirstLeg)
+		response.FirstLeg = &firstLeg
+	}
+
+	if tie.SecondLeg != nil {
+		secondLeg := ToMatchResponse(tie.SecondLeg)
+		response.SecondLeg = &secondLeg
+	}
+
+	if home, away, ok := tie.Aggregate(); ok {
+		response.AggregateHome = &home
+		response.AggregateAway = &away
+	}
+
+	if tie.WinnerTeamID != nil {
+		winnerTeamID := tie.WinnerTeamID.String()
+		response.WinnerTeamID = &winnerTeamID
+	}
+
+	return response
+}
+
+// getRoundName returns the display name for a bracket round
+func getRoundName(round, rounds int) string {
+	switch rounds - round {
+	case 0:
+		return "Final"
+	case 1:
+		return "Semi-final"
+	case 2:
+		return "Quarter-final"
+	default:
+		return fmt.Sprintf("Round of %d", 1<<(rounds-round+1))
+	}
+}
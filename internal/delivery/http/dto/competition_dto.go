@@ -0,0 +1,171 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// CreateCompetitionRequest represents create competition request body
+type CreateCompetitionRequest struct {
+	Name        string `json:"name" binding:"required,min=2,max=255"`
+	Type        string `json:"type" binding:"omitempty,oneof=league cup"`
+	Description string `json:"description" binding:"omitempty,max=1000"`
+}
+
+// UpdateCompetitionRequest represents update competition request body
+type UpdateCompetitionRequest struct {
+	Name        string `json:"name" binding:"omitempty,min=2,max=255"`
+	Type        string `json:"type" binding:"omitempty,oneof=league cup"`
+	Description string `json:"description" binding:"omitempty,max=1000"`
+}
+
+// CompetitionResponse represents competition data in response
+type CompetitionResponse struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Type        string           `json:"type"`
+	Description string           `json:"description"`
+	Seasons     []SeasonResponse `json:"seasons,omitempty"`
+	CreatedAt   string           `json:"created_at"`
+	UpdatedAt   string           `json:"updated_at"`
+}
+
+// CreateSeasonRequest represents create season request body
+type CreateSeasonRequest struct {
+	Name      string `json:"name" binding:"required,min=2,max=100"`
+	StartDate string `json:"start_date" binding:"required"` // Format: 2006-01-02
+	EndDate   string `json:"end_date" binding:"required"`   // Format: 2006-01-02
+}
+
+// UpdateSeasonRequest represents update season request body
+type UpdateSeasonRequest struct {
+	Name      string `json:"name" binding:"omitempty,min=2,max=100"`
+	StartDate string `json:"start_date" binding:"omitempty"` // Format: 2006-01-02
+	EndDate   string `json:"end_date" binding:"omitempty"`   // Format: 2006-01-02
+}
+
+// SeasonResponse represents season data in response
+type SeasonResponse struct {
+	ID            string `json:"id"`
+	CompetitionID string `json:"competition_id"`
+	Name          string `json:"name"`
+	StartDate     string `json:"start_date"`
+	EndDate       string `json:"end_date"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+// ToCompetitionEntity converts CreateCompetitionRequest to entity.Competition
+func (r *CreateCompetitionRequest) ToCompetitionEntity() *entity.Competition {
+	return &entity.Competition{
+		Name:        r.Name,
+		Type:        entity.CompetitionType(r.Type),
+		Description: r.Description,
+	}
+}
+
+// UpdateCompetitionEntity updates entity.Competition with UpdateCompetitionRequest values
+func (r *UpdateCompetitionRequest) UpdateCompetitionEntity(competition *entity.Competition) {
+	if r.Name != "" {
+		competition.Name = r.Name
+	}
+	if r.Type != "" {
+		competition.Type = entity.CompetitionType(r.Type)
+	}
+	if r.Description != "" {
+		competition.Description = r.Description
+	}
+}
+
+// ToCompetitionResponse converts entity.Competition to CompetitionResponse
+func ToCompetitionResponse(competition *entity.Competition) CompetitionResponse {
+	response := CompetitionResponse{
+		ID:          competition.ID.String(),
+		Name:        competition.Name,
+		Type:        string(competition.Type),
+		Description: competition.Description,
+		CreatedAt:   competition.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   competition.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if competition.Seasons != nil {
+		response.Seasons = ToSeasonResponseList(competition.Seasons)
+	}
+
+	return response
+}
+
+// ToCompetitionResponseList converts a slice of entity.Competition to CompetitionResponse slice
+func ToCompetitionResponseList(competitions []entity.Competition) []CompetitionResponse {
+	responses := make([]CompetitionResponse, len(competitions))
+	for i, competition := range competitions {
+		responses[i] = ToCompetitionResponse(&competition)
+	}
+	return responses
+}
+
+// ToSeasonEntity converts CreateSeasonRequest to entity.Season
+func (r *CreateSeasonRequest) ToSeasonEntity(competitionID uuid.UUID) (*entity.Season, error) {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	endDate, err := time.Parse("2006-01-02", r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Season{
+		CompetitionID: competitionID,
+		Name:          r.Name,
+		StartDate:     startDate,
+		EndDate:       endDate,
+	}, nil
+}
+
+// UpdateSeasonEntity updates entity.Season with UpdateSeasonRequest values
+func (r *UpdateSeasonRequest) UpdateSeasonEntity(season *entity.Season) error {
+	if r.Name != "" {
+		season.Name = r.Name
+	}
+	if r.StartDate != "" {
+		startDate, err := time.Parse("2006-01-02", r.StartDate)
+		if err != nil {
+			return err
+		}
+		season.StartDate = startDate
+	}
+	if r.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", r.EndDate)
+		if err != nil {
+			return err
+		}
+		season.EndDate = endDate
+	}
+	return nil
+}
+
+// ToSeasonResponse converts entity.Season to SeasonResponse
+func ToSeasonResponse(season *entity.Season) SeasonResponse {
+	return SeasonResponse{
+		ID:            season.ID.String(),
+		CompetitionID: season.CompetitionID.String(),
+		Name:          season.Name,
+		StartDate:     season.StartDate.Format("2006-01-02"),
+		EndDate:       season.EndDate.Format("2006-01-02"),
+		CreatedAt:     season.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:     season.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToSeasonResponseList converts a slice of entity.Season to SeasonResponse slice
+func ToSeasonResponseList(seasons []entity.Season) []SeasonResponse {
+	responses := make([]SeasonResponse, len(seasons))
+	for i, season := range seasons {
+		responses[i] = ToSeasonResponse(&season)
+	}
+	return responses
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// DisciplineRecordResponse represents a player's disciplinary record in response
+type DisciplineRecordResponse struct {
+	PlayerID      string               `json:"player_id"`
+	PlayerName    string               `json:"player_name"`
+	Team          *TeamSimpleResponse  `json:"team,omitempty"`
+	YellowCards   int                  `json:"yellow_cards"`
+	SecondYellows int                  `json:"second_yellows"`
+	RedCards      int                  `json:"red_cards"`
+	Suspended     bool                 `json:"suspended"`
+	Suspensions   []SuspensionResponse `json:"suspensions"`
+}
+
+// SuspensionResponse represents a suspension in response
+type SuspensionResponse struct {
+	ID        string `json:"id"`
+	Reason    string `json:"reason"`
+	MatchID   string `json:"match_id"`
+	MatchDate string `json:"match_date,omitempty"`
+	Matches   int    `json:"matches"`
+	Served    int    `json:"served"`
+	Remaining int    `json:"remaining"`
+}
+
+// ToDisciplineRecordResponse converts usecase.DisciplineRecord to DisciplineRecordResponse
+func ToDisciplineRecordResponse(record *usecase.DisciplineRecord) DisciplineRecordResponse {
+	response := DisciplineRecordResponse{
+		YellowCards:   record.YellowCards,
+		SecondYellows: record.SecondYellows,
+		RedCards:      record.RedCards,
+		Suspended:     record.Suspended,
+		Suspensions:   make([]SuspensionResponse, len(record.Suspensions)),
+	}
+
+	if record.Player != nil {
+		response.PlayerID = record.Player.ID.String()
+		response.PlayerName = record.Player.Name
+	}
+
+	if record.Team != nil {
+		team := ToTeamSimpleResponse(record.Team)
+		response.Team = &team
+	}
+
+	for i, status := range record.Suspensions {
+		suspension := status.Suspension
+		response.Suspensions[i] = SuspensionResponse{
+			ID:        suspension.ID.String(),
+			Reason:    string(suspension.Reason),
+			MatchID:   suspension.MatchID.String(),
+			Matches:   suspension.Matches,
+			Served:    status.Served,
+			Remaining: status.Remaining,
+		}
+		if suspension.Match != nil {
+			response.Suspensions[i].MatchDate = suspension.Match.MatchDate.Format("2006-01-02")
+		}
+	}
+
+	return response
+}
+
+// ToDisciplineRecordListResponse converts slice of usecase.DisciplineRecord to slice of DisciplineRecordResponse
+func ToDisciplineRecordListResponse(records []usecase.DisciplineRecord) []DisciplineRecordResponse {
+	responses := make([]DisciplineRecordResponse, len(records))
+	for i, record := range records {
+		responses[i] = ToDisciplineRecordResponse(&record)
+	}
+	return responses
+}
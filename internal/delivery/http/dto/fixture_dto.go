@@ -0,0 +1,107 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// GenerateFixturesRequest represents round-robin fixture generation request body
+type GenerateFixturesRequest struct {
+	TeamIDs          []string `json:"team_ids" binding:"required,min=2,dive,uuid"`
+	StartDate        string   `json:"start_date" binding:"required"` // Format: 2006-01-02
+	MatchDays        []string `json:"match_days" binding:"required,min=1,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	KickoffTimes     []string `json:"kickoff_times" binding:"required,min=1"` // Format: 15:04
+	DoubleRoundRobin bool     `json:"double_round_robin"`
+	DryRun           bool     `json:"dry_run"`
+}
+
+// FixtureRoundResponse represents a generated round in response
+type FixtureRoundResponse struct {
+	Round     int                    `json:"round"`
+	MatchDate string                 `json:"match_date"`
+	Matches   []FixtureMatchResponse `json:"matches"`
+}
+
+// FixtureMatchResponse represents a generated match in response
+type FixtureMatchResponse struct {
+	ID         string `json:"id,omitempty"`
+	MatchDate  string `json:"match_date"`
+	MatchTime  string `json:"match_time"`
+	HomeTeamID string `json:"home_team_id"`
+	AwayTeamID string `json:"away_team_id"`
+	GroupID    string `json:"group_id,omitempty"`
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ToFixtureInput converts GenerateFixturesRequest to usecase.FixtureInput
+func (r *GenerateFixturesRequest) ToFixtureInput(competitionID, seasonID uuid.UUID) (*usecase.FixtureInput, error) {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDs := make([]uuid.UUID, len(r.TeamIDs))
+	for i, id := range r.TeamIDs {
+		teamID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		teamIDs[i] = teamID
+	}
+
+	matchDays := make([]time.Weekday, len(r.MatchDays))
+	for i, day := range r.MatchDays {
+		matchDays[i] = weekdays[strings.ToLower(day)]
+	}
+
+	return &usecase.FixtureInput{
+		CompetitionID:    competitionID,
+		SeasonID:         seasonID,
+		TeamIDs:          teamIDs,
+		StartDate:        startDate,
+		MatchDays:        matchDays,
+		KickoffTimes:     r.KickoffTimes,
+		DoubleRoundRobin: r.DoubleRoundRobin,
+		DryRun:           r.DryRun,
+	}, nil
+}
+
+// ToFixtureRoundResponseList converts a slice of usecase.FixtureRound to FixtureRoundResponse slice
+func ToFixtureRoundResponseList(rounds []usecase.FixtureRound) []FixtureRoundResponse {
+	responses := make([]FixtureRoundResponse, len(rounds))
+	for i, round := range rounds {
+		matches := make([]FixtureMatchResponse, len(round.Matches))
+		for j, match := range round.Matches {
+			matches[j] = FixtureMatchResponse{
+				MatchDate:  match.MatchDate.Format("2006-01-02"),
+				MatchTime:  match.MatchTime,
+				HomeTeamID: match.HomeTeamID.String(),
+				AwayTeamID: match.AwayTeamID.String(),
+			}
+			if match.ID != uuid.Nil {
+				matches[j].ID = match.ID.String()
+			}
+			if match.GroupID != nil {
+				matches[j].GroupID = match.GroupID.String()
+			}
+		}
+		responses[i] = FixtureRoundResponse{
+			Round:     round.Round,
+			MatchDate: round.MatchDate.Format("2006-01-02"),
+			Matches:   matches,
+		}
+	}
+	return responses
+}
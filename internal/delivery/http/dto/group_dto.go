@@ -0,0 +1,151 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// CreateGroupRequest represents create group request body
+type CreateGroupRequest struct {
+	Name    string   `json:"name" binding:"required,min=1,max=50"`
+	TeamIDs []string `json:"team_ids" binding:"required,min=2,dive,uuid"`
+}
+
+// GenerateGroupFixturesRequest represents group stage fixture generation request body
+type GenerateGroupFixturesRequest struct {
+	StartDate        string   `json:"start_date" binding:"required"` // Format: 2006-01-02
+	MatchDays        []string `json:"match_days" binding:"required,min=1,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	KickoffTimes     []string `json:"kickoff_times" binding:"required,min=1"` // Format: 15:04
+	DoubleRoundRobin bool     `json:"double_round_robin"`
+	DryRun           bool     `json:"dry_run"`
+}
+
+// GenerateKnockoutRequest represents knockout stage generation request body
+type GenerateKnockoutRequest struct {
+	Name               string `json:"name" binding:"required,min=2,max=255"`
+	QualifiersPerGroup int    `json:"qualifiers_per_group" binding:"omitempty,min=1"`
+	BestThirdPlaced    int    `json:"best_third_placed" binding:"omitempty,min=0"`
+	TwoLegged          bool   `json:"two_legged"`
+	TwoLeggedFinal     bool   `json:"two_legged_final"`
+	StartDate          string `json:"start_date" binding:"required"`   // Format: 2006-01-02
+	KickoffTime        string `json:"kickoff_time" binding:"required"` // Format: 15:04
+	RoundIntervalDays  int    `json:"round_interval_days" binding:"omitempty,min=1,max=365"`
+	LegIntervalDays    int    `json:"leg_interval_days" binding:"omitempty,min=1,max=365"`
+}
+
+// GroupResponse represents a group with its table in response
+type GroupResponse struct {
+	ID        string             `json:"id"`
+	SeasonID  string             `json:"season_id"`
+	Name      string             `json:"name"`
+	Completed bool               `json:"completed"`
+	Standings []StandingResponse `json:"standings"`
+	CreatedAt string             `json:"created_at"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// ToGroupEntity converts CreateGroupRequest to entity.Group
+func (r *CreateGroupRequest) ToGroupEntity(seasonID uuid.UUID) (*entity.Group, error) {
+	group := &entity.Group{
+		SeasonID: seasonID,
+		Name:     r.Name,
+		Teams:    make([]entity.GroupTeam, len(r.TeamIDs)),
+	}
+
+	for i, id := range r.TeamIDs {
+		teamID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		group.Teams[i] = entity.GroupTeam{TeamID: teamID}
+	}
+
+	return group, nil
+}
+
+// ToFixtureInput converts GenerateGroupFixturesRequest to usecase.FixtureInput
+func (r *GenerateGroupFixturesRequest) ToFixtureInput(competitionID, seasonID uuid.UUID) (*usecase.FixtureInput, error) {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	matchDays := make([]time.Weekday, len(r.MatchDays))
+	for i, day := range r.MatchDays {
+		matchDays[i] = weekdays[strings.ToLower(day)]
+	}
+
+	return &usecase.FixtureInput{
+		CompetitionID:    competitionID,
+		SeasonID:         seasonID,
+		StartDate:        startDate,
+		MatchDays:        matchDays,
+		KickoffTimes:     r.KickoffTimes,
+		DoubleRoundRobin: r.DoubleRoundRobin,
+		DryRun:           r.DryRun,
+	}, nil
+}
+
+// ToKnockoutInput converts GenerateKnockoutRequest to usecase.KnockoutInput
+func (r *GenerateKnockoutRequest) ToKnockoutInput(competitionID, seasonID uuid.UUID) (*usecase.KnockoutInput, error) {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &usecase.KnockoutInput{
+		CompetitionID: competitionID,
+		SeasonID:      seasonID,
+		Rules: usecase.QualificationRules{
+			QualifiersPerGroup: r.QualifiersPerGroup,
+			BestThirdPlaced:    r.BestThirdPlaced,
+		},
+		Bracket: usecase.BracketInput{
+			Name:              r.Name,
+			TwoLegged:         r.TwoLegged,
+			TwoLeggedFinal:    r.TwoLeggedFinal,
+			StartDate:         startDate,
+			KickoffTime:       r.KickoffTime,
+			RoundIntervalDays: r.RoundIntervalDays,
+			LegIntervalDays:   r.LegIntervalDays,
+		},
+	}
+
+	if input.Rules.QualifiersPerGroup == 0 {
+		input.Rules.QualifiersPerGroup = 2
+	}
+	if input.Bracket.RoundIntervalDays == 0 {
+		input.Bracket.RoundIntervalDays = 7
+	}
+	if input.Bracket.LegIntervalDays == 0 {
+		input.Bracket.LegIntervalDays = 7
+	}
+
+	return input, nil
+}
+
+// ToGroupResponse converts usecase.GroupTable to GroupResponse
+func ToGroupResponse(table *usecase.GroupTable) GroupResponse {
+	return GroupResponse{
+		ID:        table.Group.ID.String(),
+		SeasonID:  table.Group.SeasonID.String(),
+		Name:      table.Group.Name,
+		Completed: table.Completed,
+		Standings: ToStandingResponseList(table.Standings),
+		CreatedAt: table.Group.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: table.Group.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToGroupResponseList converts a slice of usecase.GroupTable to GroupResponse slice
+func ToGroupResponseList(tables []usecase.GroupTable) []GroupResponse {
+	responses := make([]GroupResponse, len(tables))
+	for i, table := range tables {
+		responses[i] = ToGroupResponse(&table)
+	}
+	return responses
+}
@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// LineupRequest represents the squad a team names for a match
+type LineupRequest struct {
+	Formation    string                `json:"formation" binding:"omitempty,max=20"`
+	CaptainID    string                `json:"captain_id" binding:"required,uuid"`
+	GoalkeeperID string                `json:"goalkeeper_id" binding:"required,uuid"`
+	StartingXI   []LineupPlayerRequest `json:"starting_xi" binding:"required,dive"`
+	Bench        []LineupPlayerRequest `json:"bench" binding:"omitempty,dive"`
+}
+
+// LineupPlayerRequest represents a player named in a lineup request
+type LineupPlayerRequest struct {
+	PlayerID     string `json:"player_id" binding:"required,uuid"`
+	JerseyNumber int    `json:"jersey_number" binding:"omitempty,min=1,max=99"` // Defaults to the player's registered number
+}
+
+// LineupResponse represents lineup data in response
+type LineupResponse struct {
+	ID           string                 `json:"id"`
+	MatchID      string                 `json:"match_id"`
+	TeamID       string                 `json:"team_id"`
+	TeamName     string                 `json:"team_name,omitempty"`
+	Formation    string                 `json:"formation,omitempty"`
+	CaptainID    string                 `json:"captain_id"`
+	GoalkeeperID string                 `json:"goalkeeper_id"`
+	StartingXI   []LineupPlayerResponse `json:"starting_xi"`
+	Bench        []LineupPlayerResponse `json:"bench"`
+	UpdatedAt    string                 `json:"updated_at"`
+}
+
+// LineupPlayerResponse represents a player named in a lineup
+type LineupPlayerResponse struct {
+	PlayerID     string `json:"player_id"`
+	PlayerName   string `json:"player_name,omitempty"`
+	Position     string `json:"position,omitempty"`
+	JerseyNumber int    `json:"jersey_number"`
+	IsCaptain    bool   `json:"is_captain"`
+	IsGoalkeeper bool   `json:"is_goalkeeper"`
+}
+
+// ToLineupEntity converts LineupRequest to entity.Lineup
+func (r *LineupRequest) ToLineupEntity(matchID, teamID uuid.UUID) (*entity.Lineup, error) {
+	captainID, err := uuid.Parse(r.CaptainID)
+	if err != nil {
+		return nil, err
+	}
+
+	goalkeeperID, err := uuid.Parse(r.GoalkeeperID)
+	if err != nil {
+		return nil, err
+	}
+
+	lineup := &entity.Lineup{
+		MatchID:      matchID,
+		TeamID:       teamID,
+		Formation:    r.Formation,
+		CaptainID:    captainID,
+		GoalkeeperID: goalkeeperID,
+		Players:      make([]entity.LineupPlayer, 0, len(r.StartingXI)+len(r.Bench)),
+	}
+
+	for _, group := range []struct {
+		players   []LineupPlayerRequest
+		isStarter bool
+	}{{r.StartingXI, true}, {r.Bench, false}} {
+		for _, p := range group.players {
+			playerID, err := uuid.Parse(p.PlayerID)
+			if err != nil {
+				return nil, err
+			}
+			lineup.Players = append(lineup.Players, entity.LineupPlayer{
+				PlayerID:     playerID,
+				JerseyNumber: p.JerseyNumber,
+				IsStarter:    group.isStarter,
+			})
+		}
+	}
+
+	return lineup, nil
+}
+
+// ToLineupResponse converts entity.Lineup to LineupResponse
+func ToLineupResponse(lineup *entity.Lineup) LineupResponse {
+	response := LineupResponse{
+		ID:           lineup.ID.String(),
+		MatchID:      lineup.MatchID.String(),
+		TeamID:       lineup.TeamID.String(),
+		Formation:    lineup.Formation,
+		CaptainID:    lineup.CaptainID.String(),
+		GoalkeeperID: lineup.GoalkeeperID.String(),
+		StartingXI:   []LineupPlayerResponse{},
+		Bench:        []LineupPlayerResponse{},
+		UpdatedAt:    lineup.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if lineup.Team != nil {
+		response.TeamName = lineup.Team.Name
+	}
+
+	for _, named := range lineup.Players {
+		player := LineupPlayerResponse{
+			PlayerID:     named.PlayerID.String(),
+			JerseyNumber: named.JerseyNumber,
+			IsCaptain:    named.PlayerID == lineup.CaptainID,
+			IsGoalkeeper: named.PlayerID == lineup.GoalkeeperID,
+		}
+		if named.Player != nil {
+			player.PlayerName = named.Player.Name
+			player.Position = string(named.Player.Position)
+		}
+
+		if named.IsStarter {
+			response.StartingXI = append(response.StartingXI, player)
+		} else {
+			response.Bench = append(response.Bench, player)
+		}
+	}
+
+	return response
+}
+
+// ToLineupResponseList converts a slice of entity.Lineup to LineupResponse slice
+func ToLineupResponseList(lineups []entity.Lineup) []LineupResponse {
+	responses := make([]LineupResponse, len(lineups))
+	for i, lineup := range lineups {
+		responses[i] = ToLineupResponse(&lineup)
+	}
+	return responses
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// LiveGoalRequest represents a goal added while a match is in progress.
+// Without a minute the goal is timed by the match clock.
+type LiveGoalRequest struct {
+	PlayerID     string `json:"player_id" binding:"required,uuid"`
+	TeamID       string `json:"team_id" binding:"required,uuid"`
+	Minute       int    `json:"minute" binding:"omitempty,min=1,max=120"`
+	StoppageTime int    `json:"stoppage_time" binding:"omitempty,min=0,max=30"`
+	IsOwnGoal    bool   `json:"is_own_goal"`
+	IsPenalty    bool   `json:"is_penalty"`
+}
+
+// FinishMatchRequest represents finish match request body
+type FinishMatchRequest struct {
+	HomePenalties *int `json:"home_penalties" binding:"omitempty,min=0"`
+	AwayPenalties *int `json:"away_penalties" binding:"omitempty,min=0"`
+}
+
+// ToGoalInput converts LiveGoalRequest to usecase.GoalInput
+func (r *LiveGoalRequest) ToGoalInput() (*usecase.GoalInput, error) {
+	playerID, err := uuid.Parse(r.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, err := uuid.Parse(r.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.GoalInput{
+		PlayerID:     playerID,
+		TeamID:       teamID,
+		Minute:       r.Minute,
+		StoppageTime: r.StoppageTime,
+		IsOwnGoal:    r.IsOwnGoal,
+		IsPenalty:    r.IsPenalty,
+	}, nil
+}
+
+// ToFinishInput converts FinishMatchRequest to usecase.FinishInput
+func (r *FinishMatchRequest) ToFinishInput() usecase.FinishInput {
+	return usecase.FinishInput{
+		HomePenalties: r.HomePenalties,
+		AwayPenalties: r.AwayPenalties,
+	}
+}
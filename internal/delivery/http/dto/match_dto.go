@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +14,8 @@ type CreateMatchRequest struct {
 	MatchTime  string `json:"match_time" binding:"required"` // Format: 15:04
 	HomeTeamID string `json:"home_team_id" binding:"required,uuid"`
 	AwayTeamID string `json:"away_team_id" binding:"required,uuid"`
+	SeasonID   string `json:"season_id" binding:"omitempty,uuid"`
+	VenueID    string `json:"venue_id" binding:"omitempty,uuid"` // Defaults to the home team's home ground
 }
 
 // UpdateMatchRequest represents update match request body
@@ -21,54 +24,89 @@ type UpdateMatchRequest struct {
 	MatchTime  string `json:"match_time" binding:"omitempty"` // Format: 15:04
 	HomeTeamID string `json:"home_team_id" binding:"omitempty,uuid"`
 	AwayTeamID string `json:"away_team_id" binding:"omitempty,uuid"`
+	SeasonID   string `json:"season_id" binding:"omitempty,uuid"`
+	VenueID    string `json:"venue_id" binding:"omitempty,uuid"`
 	Status     string `json:"status" binding:"omitempty,oneof=scheduled ongoing completed cancelled"`
 }
 
 // RecordMatchResultRequest represents match result recording request body
 type RecordMatchResultRequest struct {
-	HomeScore int        `json:"home_score" binding:"min=0"`
-	AwayScore int        `json:"away_score" binding:"min=0"`
-	Goals     []GoalRequest `json:"goals" binding:"dive"`
+	HomeScore     int           `json:"home_score" binding:"min=0"`
+	AwayScore     int           `json:"away_score" binding:"min=0"`
+	ExtraTime     bool          `json:"extra_time"`
+	HomePenalties *int          `json:"home_penalties" binding:"omitempty,min=0"`
+	AwayPenalties *int          `json:"away_penalties" binding:"omitempty,min=0"`
+	Goals         []GoalRequest `json:"goals" binding:"dive"`
 }
 
 // GoalRequest represents a goal input
 type GoalRequest struct {
-	PlayerID  string `json:"player_id" binding:"required,uuid"`
-	TeamID    string `json:"team_id" binding:"required,uuid"`
-	Minute    int    `json:"minute" binding:"required,min=1,max=120"`
-	IsOwnGoal bool   `json:"is_own_goal"`
+	PlayerID     string `json:"player_id" binding:"required,uuid"`
+	TeamID       string `json:"team_id" binding:"required,uuid"`
+	Minute       int    `json:"minute" binding:"required,min=1,max=120"`
+	StoppageTime int    `json:"stoppage_time" binding:"omitempty,min=0,max=30"`
+	IsOwnGoal    bool   `json:"is_own_goal"`
+	IsPenalty    bool   `json:"is_penalty"`
 }
 
 // MatchResponse represents match data in response
 type MatchResponse struct {
-	ID           string              `json:"id"`
-	MatchDate    string              `json:"match_date"`
-	MatchTime    string              `json:"match_time"`
-	HomeTeamID   string              `json:"home_team_id"`
-	AwayTeamID   string              `json:"away_team_id"`
-	HomeScore    *int                `json:"home_score"`
-	AwayScore    *int                `json:"away_score"`
-	Status       string              `json:"status"`
-	StatusName   string              `json:"status_name"`
-	HomeTeam     *TeamSimpleResponse `json:"home_team,omitempty"`
-	AwayTeam     *TeamSimpleResponse `json:"away_team,omitempty"`
-	Goals        []GoalResponse      `json:"goals,omitempty"`
-	MatchResult  string              `json:"match_result,omitempty"`
-	ResultDisplay string             `json:"result_display,omitempty"`
-	CreatedAt    string              `json:"created_at"`
-	UpdatedAt    string              `json:"updated_at"`
+	ID            string               `json:"id"`
+	MatchDate     string               `json:"match_date"`
+	MatchTime     string               `json:"match_time"`
+	HomeTeamID    string               `json:"home_team_id"`
+	AwayTeamID    string               `json:"away_team_id"`
+	SeasonID      *string              `json:"season_id"`
+	GroupID       *string              `json:"group_id,omitempty"`
+	VenueID       *string              `json:"venue_id"`
+	HomeScore     *int                 `json:"home_score"`
+	AwayScore     *int                 `json:"away_score"`
+	ExtraTime     bool                 `json:"extra_time"`
+	HomePenalties *int                 `json:"home_penalties,omitempty"`
+	AwayPenalties *int                 `json:"away_penalties,omitempty"`
+	Status        string               `json:"status"`
+	StatusName    string               `json:"status_name"`
+	Period        string               `json:"period,omitempty"`
+	Clock         string               `json:"clock,omitempty"` // Running match minute, e.g. 45+2
+	HomeTeam      *TeamSimpleResponse  `json:"home_team,omitempty"`
+	AwayTeam      *TeamSimpleResponse  `json:"away_team,omitempty"`
+	Venue         *VenueSimpleResponse `json:"venue,omitempty"`
+	Goals         []GoalResponse       `json:"goals,omitempty"`
+	Timeline      []TimelineEntry      `json:"timeline,omitempty"`
+	MatchResult   string               `json:"match_result,omitempty"`
+	ResultDisplay string               `json:"result_display,omitempty"`
+	CreatedAt     string               `json:"created_at"`
+	UpdatedAt     string               `json:"updated_at"`
 }
 
 // GoalResponse represents goal data in response
 type GoalResponse struct {
-	ID         string `json:"id"`
-	MatchID    string `json:"match_id"`
-	PlayerID   string `json:"player_id"`
-	PlayerName string `json:"player_name,omitempty"`
-	TeamID     string `json:"team_id"`
-	TeamName   string `json:"team_name,omitempty"`
-	Minute     int    `json:"minute"`
-	IsOwnGoal  bool   `json:"is_own_goal"`
+	ID           string `json:"id"`
+	MatchID      string `json:"match_id"`
+	PlayerID     string `json:"player_id"`
+	PlayerName   string `json:"player_name,omitempty"`
+	TeamID       string `json:"team_id"`
+	TeamName     string `json:"team_name,omitempty"`
+	Minute       int    `json:"minute"`
+	StoppageTime int    `json:"stoppage_time"`
+	IsOwnGoal    bool   `json:"is_own_goal"`
+	IsPenalty    bool   `json:"is_penalty"`
+}
+
+// TimelineEntry represents a goal or match event in the match timeline
+type TimelineEntry struct {
+	Type         string `json:"type"`
+	Minute       int    `json:"minute"`
+	StoppageTime int    `json:"stoppage_time"`
+	Clock        string `json:"clock"` // e.g. 45+2
+	TeamID       string `json:"team_id"`
+	TeamName     string `json:"team_name,omitempty"`
+	PlayerID     string `json:"player_id"`
+	PlayerName   string `json:"player_name,omitempty"`
+	PlayerInID   string `json:"player_in_id,omitempty"`
+	PlayerInName string `json:"player_in_name,omitempty"`
+	GoalID       string `json:"goal_id,omitempty"`
+	EventID      string `json:"event_id,omitempty"`
 }
 
 // ToMatchEntity converts CreateMatchRequest to entity.Match
@@ -88,13 +126,31 @@ func (r *CreateMatchRequest) ToMatchEntity() (*entity.Match, error) {
 		return nil, err
 	}
 
-	return &entity.Match{
+	match := &entity.Match{
 		MatchDate:  matchDate,
 		MatchTime:  r.MatchTime,
 		HomeTeamID: homeTeamID,
 		AwayTeamID: awayTeamID,
 		Status:     entity.MatchStatusScheduled,
-	}, nil
+	}
+
+	if r.SeasonID != "" {
+		seasonID, err := uuid.Parse(r.SeasonID)
+		if err != nil {
+			return nil, err
+		}
+		match.SeasonID = &seasonID
+	}
+
+	if r.VenueID != "" {
+		venueID, err := uuid.Parse(r.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		match.VenueID = &venueID
+	}
+
+	return match, nil
 }
 
 // UpdateMatchEntity updates entity.Match with UpdateMatchRequest values
@@ -123,6 +179,20 @@ func (r *UpdateMatchRequest) UpdateMatchEntity(match *entity.Match) error {
 		}
 		match.AwayTeamID = awayTeamID
 	}
+	if r.SeasonID != "" {
+		seasonID, err := uuid.Parse(r.SeasonID)
+		if err != nil {
+			return err
+		}
+		match.SeasonID = &seasonID
+	}
+	if r.VenueID != "" {
+		venueID, err := uuid.Parse(r.VenueID)
+		if err != nil {
+			return err
+		}
+		match.VenueID = &venueID
+	}
 	if r.Status != "" {
 		match.Status = entity.MatchStatus(r.Status)
 	}
@@ -139,14 +209,37 @@ func ToMatchResponse(match *entity.Match) MatchResponse {
 		AwayTeamID:    match.AwayTeamID.String(),
 		HomeScore:     match.HomeScore,
 		AwayScore:     match.AwayScore,
+		ExtraTime:     match.ExtraTime,
+		HomePenalties: match.HomePenalties,
+		AwayPenalties: match.AwayPenalties,
 		Status:        string(match.Status),
 		StatusName:    getMatchStatusDisplayName(match.Status),
+		Period:        string(match.Period),
 		MatchResult:   string(match.GetResult()),
 		ResultDisplay: match.GetResultDisplay(),
 		CreatedAt:     match.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		UpdatedAt:     match.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 
+	if minute, stoppageTime, running := match.Clock(time.Now()); running {
+		response.Clock = entity.MatchClock(minute, stoppageTime)
+	}
+
+	if match.SeasonID != nil {
+		seasonID := match.SeasonID.String()
+		response.SeasonID = &seasonID
+	}
+
+	if match.GroupID != nil {
+		groupID := match.GroupID.String()
+		response.GroupID = &groupID
+	}
+
+	if match.VenueID != nil {
+		venueID := match.VenueID.String()
+		response.VenueID = &venueID
+	}
+
 	if match.HomeTeam != nil {
 		homeTeam := ToTeamSimpleResponse(match.HomeTeam)
 		response.HomeTeam = &homeTeam
@@ -157,10 +250,19 @@ func ToMatchResponse(match *entity.Match) MatchResponse {
 		response.AwayTeam = &awayTeam
 	}
 
+	if match.Venue != nil {
+		venue := ToVenueSimpleResponse(match.Venue)
+		response.Venue = &venue
+	}
+
 	if match.Goals != nil {
 		response.Goals = ToGoalResponseList(match.Goals)
 	}
 
+	if match.Goals != nil || match.Events != nil {
+		response.Timeline = ToTimeline(match.Goals, match.Events)
+	}
+
 	return response
 }
 
@@ -176,12 +278,14 @@ func ToMatchResponseList(matches []entity.Match) []MatchResponse {
 // ToGoalResponse converts entity.Goal to GoalResponse
 func ToGoalResponse(goal *entity.Goal) GoalResponse {
 	response := GoalResponse{
-		ID:        goal.ID.String(),
-		MatchID:   goal.MatchID.String(),
-		PlayerID:  goal.PlayerID.String(),
-		TeamID:    goal.TeamID.String(),
-		Minute:    goal.Minute,
-		IsOwnGoal: goal.IsOwnGoal,
+		ID:           goal.ID.String(),
+		MatchID:      goal.MatchID.String(),
+		PlayerID:     goal.PlayerID.String(),
+		TeamID:       goal.TeamID.String(),
+		Minute:       goal.Minute,
+		StoppageTime: goal.StoppageTime,
+		IsOwnGoal:    goal.IsOwnGoal,
+		IsPenalty:    goal.IsPenalty,
 	}
 
 	if goal.Player != nil {
@@ -204,6 +308,88 @@ func ToGoalResponseList(goals []entity.Goal) []GoalResponse {
 	return responses
 }
 
+// ToTimeline merges goals and match events into a single list ordered by
+// match time
+func ToTimeline(goals []entity.Goal, events []entity.MatchEvent) []TimelineEntry {
+	type timed struct {
+		entry     TimelineEntry
+		createdAt time.Time
+	}
+
+	items := make([]timed, 0, len(goals)+len(events))
+	for _, goal := range goals {
+		entry := TimelineEntry{
+			Type:         "goal",
+			Minute:       goal.Minute,
+			StoppageTime: goal.StoppageTime,
+			Clock:        entity.MatchClock(goal.Minute, goal.StoppageTime),
+			TeamID:       goal.TeamID.String(),
+			PlayerID:     goal.PlayerID.String(),
+			GoalID:       goal.ID.String(),
+		}
+		switch {
+		case goal.IsOwnGoal:
+			entry.Type = "own_goal"
+		case goal.IsPenalty:
+			entry.Type = "penalty_goal"
+		}
+		if goal.Team != nil {
+			entry.TeamName = goal.Team.Name
+		}
+		if goal.Player != nil {
+			entry.PlayerName = goal.Player.Name
+		}
+		items = append(items, timed{entry: entry, createdAt: goal.CreatedAt})
+	}
+
+	for _, event := range events {
+		entry := TimelineEntry{
+			Type:         string(event.Type),
+			Minute:       event.Minute,
+			StoppageTime: event.StoppageTime,
+			Clock:        entity.MatchClock(event.Minute, event.StoppageTime),
+			TeamID:       event.TeamID.String(),
+			PlayerID:     event.PlayerID.String(),
+			EventID:      event.ID.String(),
+		}
+		if event.Team != nil {
+			entry.TeamName = event.Team.Name
+		}
+		if event.Player != nil {
+			entry.PlayerName = event.Player.Name
+		}
+		if event.PlayerInID != nil {
+			entry.PlayerInID = event.PlayerInID.String()
+		}
+		if event.PlayerIn != nil {
+			entry.PlayerInName = event.PlayerIn.Name
+		}
+		if event.GoalID != nil {
+			entry.GoalID = event.GoalID.String()
+		}
+		items = append(items, timed{entry: entry, createdAt: event.CreatedAt})
+	}
+
+	// Order by minute, then stoppage time, then by when the entry was
+	// recorded so a goal comes before its assist
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.entry.Minute != b.entry.Minute {
+			return a.entry.Minute < b.entry.Minute
+		}
+		if a.entry.StoppageTime != b.entry.StoppageTime {
+			return a.entry.StoppageTime < b.entry.StoppageTime
+		}
+		return a.createdAt.Before(b.createdAt)
+	})
+
+	timeline := make([]TimelineEntry, len(items))
+	for i, item := range items {
+		timeline[i] = item.entry
+	}
+	return timeline
+}
+
 // getMatchStatusDisplayName returns the display name for match status
 func getMatchStatusDisplayName(status entity.MatchStatus) string {
 	switch status {
@@ -0,0 +1,125 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// MatchEventRequest represents create and update match event request body
+type MatchEventRequest struct {
+	Type         string `json:"type" binding:"required,oneof=yellow_card second_yellow red_card substitution assist penalty_missed"`
+	TeamID       string `json:"team_id" binding:"required,uuid"`
+	PlayerID     string `json:"player_id" binding:"required,uuid"`
+	Minute       int    `json:"minute" binding:"omitempty,min=1,max=120"`
+	StoppageTime int    `json:"stoppage_time" binding:"omitempty,min=0,max=30"`
+	PlayerInID   string `json:"player_in_id" binding:"omitempty,uuid"` // Substitutions only
+	GoalID       string `json:"goal_id" binding:"omitempty,uuid"`      // Assists only
+}
+
+// MatchEventResponse represents match event data in response
+type MatchEventResponse struct {
+	ID           string  `json:"id"`
+	MatchID      string  `json:"match_id"`
+	Type         string  `json:"type"`
+	TeamID       string  `json:"team_id"`
+	TeamName     string  `json:"team_name,omitempty"`
+	PlayerID     string  `json:"player_id"`
+	PlayerName   string  `json:"player_name,omitempty"`
+	Minute       int     `json:"minute"`
+	StoppageTime int     `json:"stoppage_time"`
+	Clock        string  `json:"clock"`
+	PlayerInID   *string `json:"player_in_id,omitempty"`
+	PlayerInName string  `json:"player_in_name,omitempty"`
+	GoalID       *string `json:"goal_id,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+// ToMatchEventEntity converts MatchEventRequest to entity.MatchEvent
+func (r *MatchEventRequest) ToMatchEventEntity(matchID uuid.UUID) (*entity.MatchEvent, error) {
+	teamID, err := uuid.Parse(r.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	playerID, err := uuid.Parse(r.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &entity.MatchEvent{
+		MatchID:      matchID,
+		TeamID:       teamID,
+		PlayerID:     playerID,
+		Type:         entity.MatchEventType(r.Type),
+		Minute:       r.Minute,
+		StoppageTime: r.StoppageTime,
+	}
+
+	if r.PlayerInID != "" {
+		playerInID, err := uuid.Parse(r.PlayerInID)
+		if err != nil {
+			return nil, err
+		}
+		event.PlayerInID = &playerInID
+	}
+
+	if r.GoalID != "" {
+		goalID, err := uuid.Parse(r.GoalID)
+		if err != nil {
+			return nil, err
+		}
+		event.GoalID = &goalID
+	}
+
+	return event, nil
+}
+
+// ToMatchEventResponse converts entity.MatchEvent to MatchEventResponse
+func ToMatchEventResponse(event *entity.MatchEvent) MatchEventResponse {
+	response := MatchEventResponse{
+		ID:           event.ID.String(),
+		MatchID:      event.MatchID.String(),
+		Type:         string(event.Type),
+		TeamID:       event.TeamID.String(),
+		PlayerID:     event.PlayerID.String(),
+		Minute:       event.Minute,
+		StoppageTime: event.StoppageTime,
+		Clock:        entity.MatchClock(event.Minute, event.StoppageTime),
+		CreatedAt:    event.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:    event.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if event.Team != nil {
+		response.TeamName = event.Team.Name
+	}
+
+	if event.Player != nil {
+		response.PlayerName = event.Player.Name
+	}
+
+	if event.PlayerInID != nil {
+		playerInID := event.PlayerInID.String()
+		response.PlayerInID = &playerInID
+	}
+
+	if event.PlayerIn != nil {
+		response.PlayerInName = event.PlayerIn.Name
+	}
+
+	if event.GoalID != nil {
+		goalID := event.GoalID.String()
+		response.GoalID = &goalID
+	}
+
+	return response
+}
+
+// ToMatchEventResponseList converts a slice of entity.MatchEvent to MatchEventResponse slice
+func ToMatchEventResponseList(events []entity.MatchEvent) []MatchEventResponse {
+	responses := make([]MatchEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = ToMatchEventResponse(&event)
+	}
+	return responses
+}
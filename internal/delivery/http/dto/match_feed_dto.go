@@ -0,0 +1,57 @@
+package dto
+
+import "github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+
+// MatchFeedEventResponse represents a live match update pushed to stream clients
+type MatchFeedEventResponse struct {
+	Type       string                 `json:"type"`
+	MatchID    string                 `json:"match_id"`
+	Status     string                 `json:"status"`
+	Period     string                 `json:"period,omitempty"`
+	HomeTeamID string                 `json:"home_team_id"`
+	AwayTeamID string                 `json:"away_team_id"`
+	HomeScore  *int                   `json:"home_score"`
+	AwayScore  *int                   `json:"away_score"`
+	Goal       *MatchFeedGoalResponse `json:"goal,omitempty"`
+	OccurredAt string                 `json:"occurred_at"`
+}
+
+// MatchFeedGoalResponse represents the goal a live update is about
+type MatchFeedGoalResponse struct {
+	ID           string `json:"id"`
+	PlayerID     string `json:"player_id"`
+	TeamID       string `json:"team_id"`
+	Minute       int    `json:"minute"`
+	StoppageTime int    `json:"stoppage_time"`
+	IsOwnGoal    bool   `json:"is_own_goal"`
+	IsPenalty    bool   `json:"is_penalty"`
+}
+
+// ToMatchFeedEventResponse converts usecase.MatchFeedEvent to MatchFeedEventResponse
+func ToMatchFeedEventResponse(event *usecase.MatchFeedEvent) MatchFeedEventResponse {
+	response := MatchFeedEventResponse{
+		Type:       string(event.Type),
+		MatchID:    event.MatchID.String(),
+		Status:     string(event.Status),
+		Period:     string(event.Period),
+		HomeTeamID: event.HomeTeamID.String(),
+		AwayTeamID: event.AwayTeamID.String(),
+		HomeScore:  event.HomeScore,
+		AwayScore:  event.AwayScore,
+		OccurredAt: event.OccurredAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if event.Goal != nil {
+		response.Goal = &MatchFeedGoalResponse{
+			ID:           event.Goal.ID.String(),
+			PlayerID:     event.Goal.PlayerID.String(),
+			TeamID:       event.Goal.TeamID.String(),
+			Minute:       event.Goal.Minute,
+			StoppageTime: event.Goal.StoppageTime,
+			IsOwnGoal:    event.Goal.IsOwnGoal,
+			IsPenalty:    event.Goal.IsPenalty,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,212 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// CreateOfficialRequest represents create official request body
+type CreateOfficialRequest struct {
+	Name                string `json:"name" binding:"required,min=2,max=255"`
+	Grade               string `json:"grade" binding:"required,oneof=international national regional local"`
+	CertificationExpiry string `json:"certification_expiry" binding:"required"` // Format: 2006-01-02
+}
+
+// UpdateOfficialRequest represents update official request body
+type UpdateOfficialRequest struct {
+	Name                string `json:"name" binding:"omitempty,min=2,max=255"`
+	Grade               string `json:"grade" binding:"omitempty,oneof=international national regional local"`
+	CertificationExpiry string `json:"certification_expiry" binding:"omitempty"` // Format: 2006-01-02
+}
+
+// CreateOfficialAccountRequest represents the login account created for an official
+type CreateOfficialAccountRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6,max=72"`
+}
+
+// AssignOfficialsRequest represents the officials assigned to a match
+type AssignOfficialsRequest struct {
+	RefereeID        string `json:"referee_id" binding:"required,uuid"`
+	Assistant1ID     string `json:"assistant_1_id" binding:"omitempty,uuid"`
+	Assistant2ID     string `json:"assistant_2_id" binding:"omitempty,uuid"`
+	FourthOfficialID string `json:"fourth_official_id" binding:"omitempty,uuid"`
+}
+
+// OfficialResponse represents official data in response
+type OfficialResponse struct {
+	ID                  string  `json:"id"`
+	Name                string  `json:"name"`
+	Grade               string  `json:"grade"`
+	CertificationExpiry string  `json:"certification_expiry"`
+	UserID              *string `json:"user_id"` // Login account, if any
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+}
+
+// OfficialSimpleResponse represents simplified official data
+type OfficialSimpleResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Grade string `json:"grade"`
+}
+
+// MatchOfficialResponse represents an official assigned to a match
+type MatchOfficialResponse struct {
+	Role       string                  `json:"role"`
+	OfficialID string                  `json:"official_id"`
+	Official   *OfficialSimpleResponse `json:"official,omitempty"`
+	ReportedAt *string                 `json:"reported_at"`
+}
+
+// OfficialAssignmentResponse represents a match in an official's schedule
+type OfficialAssignmentResponse struct {
+	Role       string        `json:"role"`
+	ReportedAt *string       `json:"reported_at"`
+	Match      MatchResponse `json:"match"`
+}
+
+// OfficialScheduleResponse represents an official's schedule in response
+type OfficialScheduleResponse struct {
+	Official OfficialResponse             `json:"official"`
+	Matches  []OfficialAssignmentResponse `json:"matches"`
+}
+
+// ToOfficialEntity converts CreateOfficialRequest to entity.Official
+func (r *CreateOfficialRequest) ToOfficialEntity() (*entity.Official, error) {
+	expiry, err := time.Parse("2006-01-02", r.CertificationExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Official{
+		Name:                r.Name,
+		Grade:               entity.OfficialGrade(r.Grade),
+		CertificationExpiry: expiry,
+	}, nil
+}
+
+// UpdateOfficialEntity updates entity.Official with UpdateOfficialRequest values
+func (r *UpdateOfficialRequest) UpdateOfficialEntity(official *entity.Official) error {
+	if r.Name != "" {
+		official.Name = r.Name
+	}
+	if r.Grade != "" {
+		official.Grade = entity.OfficialGrade(r.Grade)
+	}
+	if r.CertificationExpiry != "" {
+		expiry, err := time.Parse("2006-01-02", r.CertificationExpiry)
+		if err != nil {
+			return err
+		}
+		official.CertificationExpiry = expiry
+	}
+	return nil
+}
+
+// ToMatchOfficials converts AssignOfficialsRequest to the match's assignments
+func (r *AssignOfficialsRequest) ToMatchOfficials() ([]entity.MatchOfficial, error) {
+	roles := []struct {
+		role       entity.OfficialRole
+		officialID string
+	}{
+		{entity.OfficialRoleReferee, r.RefereeID},
+		{entity.OfficialRoleAssistant1, r.Assistant1ID},
+		{entity.OfficialRoleAssistant2, r.Assistant2ID},
+		{entity.OfficialRoleFourthOfficial, r.FourthOfficialID},
+	}
+
+	var assignments []entity.MatchOfficial
+	for _, role := range roles {
+		if role.officialID == "" {
+			continue
+		}
+		officialID, err := uuid.Parse(role.officialID)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, entity.MatchOfficial{OfficialID: officialID, Role: role.role})
+	}
+	return assignments, nil
+}
+
+// ToOfficialResponse converts entity.Official to OfficialResponse
+func ToOfficialResponse(official *entity.Official) OfficialResponse {
+	response := OfficialResponse{
+		ID:                  official.ID.String(),
+		Name:                official.Name,
+		Grade:               string(official.Grade),
+		CertificationExpiry: official.CertificationExpiry.Format("2006-01-02"),
+		CreatedAt:           official.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:           official.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if official.UserID != nil {
+		userID := official.UserID.String()
+		response.UserID = &userID
+	}
+
+	return response
+}
+
+// ToOfficialResponseList converts a slice of entity.Official to OfficialResponse slice
+func ToOfficialResponseList(officials []entity.Official) []OfficialResponse {
+	responses := make([]OfficialResponse, len(officials))
+	for i, official := range officials {
+		responses[i] = ToOfficialResponse(&official)
+	}
+	return responses
+}
+
+// ToMatchOfficialResponseList converts a slice of entity.MatchOfficial to MatchOfficialResponse slice
+func ToMatchOfficialResponseList(assignments []entity.MatchOfficial) []MatchOfficialResponse {
+	responses := make([]MatchOfficialResponse, len(assignments))
+	for i, assignment := range assignments {
+		responses[i] = MatchOfficialResponse{
+			Role:       string(assignment.Role),
+			OfficialID: assignment.OfficialID.String(),
+			ReportedAt: formatReportedAt(assignment.ReportedAt),
+		}
+		if assignment.Official != nil {
+			responses[i].Official = &OfficialSimpleResponse{
+				ID:    assignment.Official.ID.String(),
+				Name:  assignment.Official.Name,
+				Grade: string(assignment.Official.Grade),
+			}
+		}
+	}
+	return responses
+}
+
+// ToOfficialScheduleResponse converts usecase.OfficialSchedule to OfficialScheduleResponse
+func ToOfficialScheduleResponse(schedule *usecase.OfficialSchedule) OfficialScheduleResponse {
+	response := OfficialScheduleResponse{
+		Official: ToOfficialResponse(schedule.Official),
+		Matches:  make([]OfficialAssignmentResponse, 0, len(schedule.Assignments)),
+	}
+
+	for _, assignment := range schedule.Assignments {
+		if assignment.Match == nil {
+			continue
+		}
+		response.Matches = append(response.Matches, OfficialAssignmentResponse{
+			Role:       string(assignment.Role),
+			ReportedAt: formatReportedAt(assignment.ReportedAt),
+			Match:      ToMatchResponse(assignment.Match),
+		})
+	}
+
+	return response
+}
+
+// formatReportedAt formats the time a match report was submitted, if it was
+func formatReportedAt(reportedAt *time.Time) *string {
+	if reportedAt == nil {
+		return nil
+	}
+	formatted := reportedAt.UTC().Format("2006-01-02T15:04:05Z")
+	return &formatted
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"math"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// MatchPredictionResponse represents a match prediction in response
+type MatchPredictionResponse struct {
+	MatchID       string                 `json:"match_id"`
+	Probabilities OutcomeProbabilities   `json:"probabilities"`
+	ExpectedGoals ExpectedGoalsResponse  `json:"expected_goals"`
+	LikelyScore   PredictedScoreResponse `json:"likely_score"`
+	SampleSize    int                    `json:"sample_size"`
+}
+
+// OutcomeProbabilities represents the chance of each result
+type OutcomeProbabilities struct {
+	HomeWin float64 `json:"home_win"`
+	Draw    float64 `json:"draw"`
+	AwayWin float64 `json:"away_win"`
+}
+
+// ExpectedGoalsResponse represents each side's expected goals
+type ExpectedGoalsResponse struct {
+	Home float64 `json:"home"`
+	Away float64 `json:"away"`
+}
+
+// PredictedScoreResponse represents the most likely scoreline
+type PredictedScoreResponse struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
+}
+
+// ToMatchPredictionResponse converts usecase.MatchPrediction to MatchPredictionResponse
+func ToMatchPredictionResponse(prediction *usecase.MatchPrediction) MatchPredictionResponse {
+	return MatchPredictionResponse{
+		MatchID: prediction.Match.ID.String(),
+		Probabilities: OutcomeProbabilities{
+			HomeWin: roundProbability(prediction.HomeWin),
+			Draw:    roundProbability(prediction.Draw),
+			AwayWin: roundProbability(prediction.AwayWin),
+		},
+		ExpectedGoals: ExpectedGoalsResponse{
+			Home: math.Round(prediction.HomeExpected*100) / 100,
+			Away: math.Round(prediction.AwayExpected*100) / 100,
+		},
+		LikelyScore: PredictedScoreResponse{
+			Home: prediction.HomeScore,
+			Away: prediction.AwayScore,
+		},
+		SampleSize: prediction.SampleSize,
+	}
+}
+
+// roundProbability rounds a probability to three decimal places
+func roundProbability(p float64) float64 {
+	return math.Round(p*1000) / 1000
+}
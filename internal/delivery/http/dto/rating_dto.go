@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// TeamRatingResponse represents a row of the rating table in response
+type TeamRatingResponse struct {
+	Position int                 `json:"position"`
+	TeamID   string              `json:"team_id"`
+	Team     *TeamSimpleResponse `json:"team,omitempty"`
+	Rating   float64             `json:"rating"`
+	Matches  int                 `json:"matches"`
+}
+
+// TeamRatingHistoryResponse represents a team's rating history in response
+type TeamRatingHistoryResponse struct {
+	Team    TeamSimpleResponse      `json:"team"`
+	Rating  float64                 `json:"rating"`
+	History []RatingHistoryResponse `json:"history"`
+}
+
+// RatingHistoryResponse represents the rating change from one match in response
+type RatingHistoryResponse struct {
+	MatchID      string              `json:"match_id"`
+	MatchDate    string              `json:"match_date,omitempty"`
+	Opponent     *TeamSimpleResponse `json:"opponent,omitempty"`
+	Score        string              `json:"score,omitempty"` // Team's goals first
+	RatingBefore float64             `json:"rating_before"`
+	RatingAfter  float64             `json:"rating_after"`
+	Change       float64             `json:"change"`
+}
+
+// ToTeamRatingResponseList converts a ranked slice of entity.TeamRating to TeamRatingResponse slice
+func ToTeamRatingResponseList(ratings []entity.TeamRating) []TeamRatingResponse {
+	responses := make([]TeamRatingResponse, len(ratings))
+	for i, rating := range ratings {
+		responses[i] = TeamRatingResponse{
+			Position: i + 1,
+			TeamID:   rating.TeamID.String(),
+			Rating:   roundRating(rating.Rating),
+			Matches:  rating.Matches,
+		}
+		if rating.Team != nil {
+			team := ToTeamSimpleResponse(rating.Team)
+			responses[i].Team = &team
+		}
+	}
+	return responses
+}
+
+// ToTeamRatingHistoryResponse converts usecase.TeamRatingHistory to TeamRatingHistoryResponse
+func ToTeamRatingHistoryResponse(record *usecase.TeamRatingHistory) TeamRatingHistoryResponse {
+	response := TeamRatingHistoryResponse{
+		Team:    ToTeamSimpleResponse(record.Team),
+		Rating:  roundRating(record.Rating),
+		History: make([]RatingHistoryResponse, len(record.History)),
+	}
+
+	for i, entry := range record.History {
+		response.History[i] = RatingHistoryResponse{
+			MatchID:      entry.MatchID.String(),
+			RatingBefore: roundRating(entry.RatingBefore),
+			RatingAfter:  roundRating(entry.RatingAfter),
+			Change:       roundRating(entry.Change()),
+		}
+		if entry.Opponent != nil {
+			opponent := ToTeamSimpleResponse(entry.Opponent)
+			response.History[i].Opponent = &opponent
+		}
+		if entry.Match != nil {
+			response.History[i].MatchDate = entry.Match.MatchDate.Format("2006-01-02")
+			if score, ok := toTeamScore(entry.Match, entry.TeamID); ok {
+				response.History[i].Score = score
+			}
+		}
+	}
+
+	return response
+}
+
+// toTeamScore formats a match score with the team's goals first
+func toTeamScore(match *entity.Match, teamID uuid.UUID) (string, bool) {
+	if match.HomeScore == nil || match.AwayScore == nil {
+		return "", false
+	}
+	if teamID == match.AwayTeamID {
+		return fmt.Sprintf("%d-%d", *match.AwayScore, *match.HomeScore), true
+	}
+	return fmt.Sprintf("%d-%d", *match.HomeScore, *match.AwayScore), true
+}
+
+// roundRating rounds a rating to one decimal place
+func roundRating(rating float64) float64 {
+	return math.Round(rating*10) / 10
+}
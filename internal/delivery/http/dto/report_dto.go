@@ -1,23 +1,26 @@
 package dto
 
 import (
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
 )
 
 // MatchReportResponse represents match report data in response
 type MatchReportResponse struct {
-	Match              MatchResponse       `json:"match"`
-	HomeTeam           TeamSimpleResponse  `json:"home_team"`
-	AwayTeam           TeamSimpleResponse  `json:"away_team"`
-	HomeScore          int                 `json:"home_score"`
-	AwayScore          int                 `json:"away_score"`
-	MatchResult        string              `json:"match_result"`
-	MatchResultDisplay string              `json:"match_result_display"`
-	Goals              []GoalResponse      `json:"goals"`
-	TopScorer          *TopScorerResponse  `json:"top_scorer,omitempty"`
-	HomeTeamTotalWins  int64               `json:"home_team_total_wins"`
-	AwayTeamTotalWins  int64               `json:"away_team_total_wins"`
+	Match              MatchResponse      `json:"match"`
+	HomeTeam           TeamSimpleResponse `json:"home_team"`
+	AwayTeam           TeamSimpleResponse `json:"away_team"`
+	HomeScore          int                `json:"home_score"`
+	AwayScore          int                `json:"away_score"`
+	MatchResult        string             `json:"match_result"`
+	MatchResultDisplay string             `json:"match_result_display"`
+	Goals              []GoalResponse     `json:"goals"`
+	Timeline           []TimelineEntry    `json:"timeline"`
+	TopScorer          *TopScorerResponse `json:"top_scorer,omitempty"`
+	HomeTeamTotalWins  int64              `json:"home_team_total_wins"`
+	AwayTeamTotalWins  int64              `json:"away_team_total_wins"`
 }
 
 // TopScorerResponse represents top scorer data in response
@@ -29,6 +32,21 @@ type TopScorerResponse struct {
 	GoalCount  int64  `json:"goal_count"`
 }
 
+// MatchReportListMeta represents the pagination metadata of match reports,
+// with the top scorer across every page
+type MatchReportListMeta struct {
+	*response.Meta
+	TopScorer *TopScorerResponse `json:"top_scorer"`
+}
+
+// ToMatchReportListMeta builds MatchReportListMeta from a page of reports
+func ToMatchReportListMeta(reportPage *usecase.MatchReportPage, page, limit int) MatchReportListMeta {
+	return MatchReportListMeta{
+		Meta:      response.NewMeta(page, limit, reportPage.Total),
+		TopScorer: ToTopScorerResponse(reportPage.TopScorer),
+	}
+}
+
 // ToMatchReportResponse converts usecase.MatchReport to MatchReportResponse
 func ToMatchReportResponse(report *usecase.MatchReport) MatchReportResponse {
 	response := MatchReportResponse{
@@ -53,6 +71,8 @@ func ToMatchReportResponse(report *usecase.MatchReport) MatchReportResponse {
 		response.Goals = ToGoalResponseList(report.Goals)
 	}
 
+	response.Timeline = ToTimeline(report.Goals, report.Events)
+
 	if report.TopScorer != nil {
 		response.TopScorer = ToTopScorerResponse(report.TopScorer)
 	}
@@ -91,3 +111,149 @@ func ToTopScorerResponseList(scorers []repository.TopScorerResult) []TopScorerRe
 	}
 	return responses
 }
+
+// StandingResponse represents a league table row in response
+type StandingResponse struct {
+	Position     int                 `json:"position"`
+	TeamID       string              `json:"team_id"`
+	Team         *TeamSimpleResponse `json:"team,omitempty"`
+	Played       int64               `json:"played"`
+	Won          int64               `json:"won"`
+	Drawn        int64               `json:"drawn"`
+	Lost         int64               `json:"lost"`
+	GoalsFor     int64               `json:"goals_for"`
+	GoalsAgainst int64               `json:"goals_against"`
+	GoalDiff     int64               `json:"goal_difference"`
+	Points       int64               `json:"points"`
+}
+
+// ToStandingResponse converts usecase.LeaderboardEntry to StandingResponse
+func ToStandingResponse(entry *usecase.LeaderboardEntry) StandingResponse {
+	response := StandingResponse{
+		Position:     entry.Position,
+		TeamID:       entry.TeamID.String(),
+		Played:       entry.Played,
+		Won:          entry.Won,
+		Drawn:        entry.Drawn,
+		Lost:         entry.Lost,
+		GoalsFor:     entry.GoalsFor,
+		GoalsAgainst: entry.GoalsAgainst,
+		GoalDiff:     entry.GoalDiff,
+		Points:       entry.Points,
+	}
+
+	if entry.Team != nil {
+		team := ToTeamSimpleResponse(entry.Team)
+		response.Team = &team
+	}
+
+	return response
+}
+
+// ToStandingResponseList converts a slice of usecase.LeaderboardEntry to StandingResponse slice
+func ToStandingResponseList(entries []usecase.LeaderboardEntry) []StandingResponse {
+	responses := make([]StandingResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = ToStandingResponse(&entry)
+	}
+	return responses
+}
+
+// HeadToHeadResponse represents two teams' record against each other in response
+type HeadToHeadResponse struct {
+	TeamA         TeamSimpleResponse         `json:"team_a"`
+	TeamB         TeamSimpleResponse         `json:"team_b"`
+	Played        int                        `json:"played"`
+	TeamAWins     int                        `json:"team_a_wins"`
+	TeamBWins     int                        `json:"team_b_wins"`
+	Draws         int                        `json:"draws"`
+	TeamAGoals    int                        `json:"team_a_goals"`
+	TeamBGoals    int                        `json:"team_b_goals"`
+	LargestResult *HeadToHeadResultResponse  `json:"largest_result"`
+	TopScorers    []HeadToHeadScorerResponse `json:"top_scorers"`
+	Matches       []HeadToHeadResultResponse `json:"matches"`
+}
+
+// HeadToHeadResultResponse represents a meeting of the two teams
+type HeadToHeadResultResponse struct {
+	MatchID   string             `json:"match_id"`
+	MatchDate string             `json:"match_date"`
+	HomeTeam  TeamSimpleResponse `json:"home_team"`
+	AwayTeam  TeamSimpleResponse `json:"away_team"`
+	HomeScore int                `json:"home_score"`
+	AwayScore int                `json:"away_score"`
+	Winner    *string            `json:"winner_team_id"` // Null for a draw
+}
+
+// HeadToHeadScorerResponse represents a player's goals in the fixture
+type HeadToHeadScorerResponse struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	TeamID     string `json:"team_id"`
+	Goals      int    `json:"goals"`
+}
+
+// ToHeadToHeadResponse converts usecase.HeadToHead to HeadToHeadResponse
+func ToHeadToHeadResponse(record *usecase.HeadToHead) HeadToHeadResponse {
+	response := HeadToHeadResponse{
+		TeamA:      ToTeamSimpleResponse(record.TeamA),
+		TeamB:      ToTeamSimpleResponse(record.TeamB),
+		Played:     record.TeamAWins + record.TeamBWins + record.Draws,
+		TeamAWins:  record.TeamAWins,
+		TeamBWins:  record.TeamBWins,
+		Draws:      record.Draws,
+		TeamAGoals: record.TeamAGoals,
+		TeamBGoals: record.TeamBGoals,
+		TopScorers: make([]HeadToHeadScorerResponse, len(record.TopScorers)),
+		Matches:    make([]HeadToHeadResultResponse, 0, len(record.Matches)),
+	}
+
+	if record.LargestResult != nil {
+		largest := toHeadToHeadResultResponse(record.LargestResult.Match)
+		response.LargestResult = &largest
+	}
+
+	for i, scorer := range record.TopScorers {
+		response.TopScorers[i] = HeadToHeadScorerResponse{
+			PlayerID: scorer.PlayerID.String(),
+			TeamID:   scorer.TeamID.String(),
+			Goals:    scorer.Goals,
+		}
+		if scorer.Player != nil {
+			response.TopScorers[i].PlayerName = scorer.Player.Name
+		}
+	}
+
+	for i := range record.Matches {
+		if record.Matches[i].HomeScore == nil || record.Matches[i].AwayScore == nil {
+			continue
+		}
+		response.Matches = append(response.Matches, toHeadToHeadResultResponse(&record.Matches[i]))
+	}
+
+	return response
+}
+
+// toHeadToHeadResultResponse converts a completed entity.Match to HeadToHeadResultResponse
+func toHeadToHeadResultResponse(match *entity.Match) HeadToHeadResultResponse {
+	response := HeadToHeadResultResponse{
+		MatchID:   match.ID.String(),
+		MatchDate: match.MatchDate.Format("2006-01-02"),
+		HomeTeam:  ToTeamSimpleResponse(match.HomeTeam),
+		AwayTeam:  ToTeamSimpleResponse(match.AwayTeam),
+		HomeScore: *match.HomeScore,
+		AwayScore: *match.AwayScore,
+	}
+
+	var winner string
+	switch {
+	case response.HomeScore > response.AwayScore:
+		winner = match.HomeTeamID.String()
+	case response.AwayScore > response.HomeScore:
+		winner = match.AwayTeamID.String()
+	}
+	if winner != "" {
+		response.Winner = &winner
+	}
+	return response
+}
@@ -0,0 +1,181 @@
+package dto
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// PlayerStatsResponse represents a player's scoring statistics in response
+type PlayerStatsResponse struct {
+	Player          PlayerResponse          `json:"player"`
+	StartDate       *string                 `json:"start_date"`
+	EndDate         *string                 `json:"end_date"`
+	Appearances     int                     `json:"appearances"`
+	Goals           int                     `json:"goals"`
+	PenaltyGoals    int                     `json:"penalty_goals"`
+	OwnGoals        int                     `json:"own_goals"`
+	GoalsPerMatch   float64                 `json:"goals_per_match"`
+	MatchesWithGoal int                     `json:"matches_with_goal"`
+	FirstGoalDate   *string                 `json:"first_goal_date"`
+	LastGoalDate    *string                 `json:"last_goal_date"`
+	HomeGoals       int                     `json:"home_goals"`
+	AwayGoals       int                     `json:"away_goals"`
+	ByMinute        []MinuteBucketResponse  `json:"by_minute"`
+	ByOpponent      []OpponentGoalsResponse `json:"by_opponent"`
+}
+
+// MinuteBucketResponse represents the goals scored in a span of minutes
+type MinuteBucketResponse struct {
+	Minutes string `json:"minutes"`
+	Goals   int    `json:"goals"`
+}
+
+// OpponentGoalsResponse represents the goals scored against one team
+type OpponentGoalsResponse struct {
+	Team  TeamSimpleResponse `json:"team"`
+	Goals int                `json:"goals"`
+}
+
+// TeamStatsResponse represents a team's statistics in response
+type TeamStatsResponse struct {
+	Team        TeamSimpleResponse       `json:"team"`
+	StartDate   *string                  `json:"start_date"`
+	EndDate     *string                  `json:"end_date"`
+	Overall     TeamRecordResponse       `json:"overall"`
+	Home        TeamRecordResponse       `json:"home"`
+	Away        TeamRecordResponse       `json:"away"`
+	BiggestWin  *TeamMatchResultResponse `json:"biggest_win"`
+	BiggestLoss *TeamMatchResultResponse `json:"biggest_loss"`
+	Streaks     TeamStreaksResponse      `json:"streaks"`
+	Form        string                   `json:"form"`
+}
+
+// TeamRecordResponse represents a team's results over a set of matches
+type TeamRecordResponse struct {
+	Played         int `json:"played"`
+	Won            int `json:"won"`
+	Drawn          int `json:"drawn"`
+	Lost           int `json:"lost"`
+	GoalsFor       int `json:"goals_for"`
+	GoalsAgainst   int `json:"goals_against"`
+	GoalDifference int `json:"goal_difference"`
+	CleanSheets    int `json:"clean_sheets"`
+	FailedToScore  int `json:"failed_to_score"`
+}
+
+// TeamMatchResultResponse represents a match from one team's point of view
+type TeamMatchResultResponse struct {
+	MatchID   string              `json:"match_id"`
+	MatchDate string              `json:"match_date"`
+	Opponent  *TeamSimpleResponse `json:"opponent,omitempty"`
+	IsHome    bool                `json:"is_home"`
+	Score     string              `json:"score"` // Team's goals first, e.g. 4-0
+	Outcome   string              `json:"outcome"`
+}
+
+// TeamStreaksResponse represents a team's current and longest runs
+type TeamStreaksResponse struct {
+	Win      StreakResponse `json:"win"`
+	Unbeaten StreakResponse `json:"unbeaten"`
+	Losing   StreakResponse `json:"losing"`
+}
+
+// StreakResponse represents a run of results
+type StreakResponse struct {
+	Current int `json:"current"`
+	Longest int `json:"longest"`
+}
+
+// ToPlayerStatsResponse converts usecase.PlayerStats to PlayerStatsResponse
+func ToPlayerStatsResponse(stats *usecase.PlayerStats) PlayerStatsResponse {
+	response := PlayerStatsResponse{
+		Player:          ToPlayerResponse(stats.Player),
+		StartDate:       formatOptionalDate(stats.Range.From),
+		EndDate:         formatOptionalDate(stats.Range.To),
+		Appearances:     stats.Appearances,
+		Goals:           stats.Goals,
+		PenaltyGoals:    stats.PenaltyGoals,
+		OwnGoals:        stats.OwnGoals,
+		GoalsPerMatch:   math.Round(stats.GoalsPerMatch*100) / 100,
+		MatchesWithGoal: stats.MatchesWithGoal,
+		FirstGoalDate:   formatOptionalDate(stats.FirstGoalDate),
+		LastGoalDate:    formatOptionalDate(stats.LastGoalDate),
+		HomeGoals:       stats.HomeGoals,
+		AwayGoals:       stats.AwayGoals,
+		ByMinute:        make([]MinuteBucketResponse, len(stats.ByMinute)),
+		ByOpponent:      make([]OpponentGoalsResponse, len(stats.ByOpponent)),
+	}
+
+	for i, bucket := range stats.ByMinute {
+		response.ByMinute[i] = MinuteBucketResponse{
+			Minutes: bucket.Label(),
+			Goals:   bucket.Goals,
+		}
+	}
+
+	for i, opponent := range stats.ByOpponent {
+		response.ByOpponent[i] = OpponentGoalsResponse{
+			Team:  ToTeamSimpleResponse(opponent.Team),
+			Goals: opponent.Goals,
+		}
+	}
+
+	return response
+}
+
+// ToTeamStatsResponse converts usecase.TeamStats to TeamStatsResponse
+func ToTeamStatsResponse(stats *usecase.TeamStats) TeamStatsResponse {
+	return TeamStatsResponse{
+		Team:        ToTeamSimpleResponse(stats.Team),
+		StartDate:   formatOptionalDate(stats.Range.From),
+		EndDate:     formatOptionalDate(stats.Range.To),
+		Overall:     toTeamRecordResponse(stats.Overall),
+		Home:        toTeamRecordResponse(stats.Home),
+		Away:        toTeamRecordResponse(stats.Away),
+		BiggestWin:  toTeamMatchResultResponse(stats.BiggestWin),
+		BiggestLoss: toTeamMatchResultResponse(stats.BiggestLoss),
+		Streaks: TeamStreaksResponse{
+			Win:      StreakResponse(stats.WinStreak),
+			Unbeaten: StreakResponse(stats.Unbeaten),
+			Losing:   StreakResponse(stats.LosingRun),
+		},
+		Form: stats.Form,
+	}
+}
+
+// toTeamRecordResponse converts usecase.TeamRecord to TeamRecordResponse
+func toTeamRecordResponse(record usecase.TeamRecord) TeamRecordResponse {
+	return TeamRecordResponse{
+		Played:         record.Played,
+		Won:            record.Won,
+		Drawn:          record.Drawn,
+		Lost:           record.Lost,
+		GoalsFor:       record.GoalsFor,
+		GoalsAgainst:   record.GoalsAgainst,
+		GoalDifference: record.GoalsFor - record.GoalsAgainst,
+		CleanSheets:    record.CleanSheets,
+		FailedToScore:  record.FailedToScore,
+	}
+}
+
+// toTeamMatchResultResponse converts usecase.TeamMatchResult to TeamMatchResultResponse
+func toTeamMatchResultResponse(result *usecase.TeamMatchResult) *TeamMatchResultResponse {
+	if result == nil {
+		return nil
+	}
+
+	response := &TeamMatchResultResponse{
+		MatchID:   result.Match.ID.String(),
+		MatchDate: result.Match.MatchDate.Format("2006-01-02"),
+		IsHome:    result.IsHome,
+		Score:     fmt.Sprintf("%d-%d", result.GoalsFor, result.GoalsAgainst),
+		Outcome:   string(result.Outcome),
+	}
+	if result.Opponent != nil {
+		opponent := ToTeamSimpleResponse(result.Opponent)
+		response.Opponent = &opponent
+	}
+	return response
+}
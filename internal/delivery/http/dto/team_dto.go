@@ -12,6 +12,7 @@ type CreateTeamRequest struct {
 	FoundedYear int    `json:"founded_year" binding:"required,min=1800,max=2100"`
 	Address     string `json:"address" binding:"omitempty,max=500"`
 	City        string `json:"city" binding:"required,min=2,max=100"`
+	HomeVenueID string `json:"home_venue_id" binding:"omitempty,uuid"`
 }
 
 // UpdateTeamRequest represents update team request body
@@ -21,34 +22,47 @@ type UpdateTeamRequest struct {
 	FoundedYear int    `json:"founded_year" binding:"omitempty,min=1800,max=2100"`
 	Address     string `json:"address" binding:"omitempty,max=500"`
 	City        string `json:"city" binding:"omitempty,min=2,max=100"`
+	HomeVenueID string `json:"home_venue_id" binding:"omitempty,uuid"`
 }
 
 // TeamResponse represents team data in response
 type TeamResponse struct {
-	ID          string           `json:"id"`
-	Name        string           `json:"name"`
-	Logo        string           `json:"logo"`
-	FoundedYear int              `json:"founded_year"`
-	Address     string           `json:"address"`
-	City        string           `json:"city"`
-	Players     []PlayerResponse `json:"players,omitempty"`
-	CreatedAt   string           `json:"created_at"`
-	UpdatedAt   string           `json:"updated_at"`
+	ID           string                       `json:"id"`
+	Name         string                       `json:"name"`
+	Logo         string                       `json:"logo"`
+	FoundedYear  int                          `json:"founded_year"`
+	Address      string                       `json:"address"`
+	City         string                       `json:"city"`
+	HomeVenueID  *string                      `json:"home_venue_id"`
+	Players      []PlayerResponse             `json:"players,omitempty"`
+	Availability []PlayerAvailabilityResponse `json:"availability,omitempty"`
+	CreatedAt    string                       `json:"created_at"`
+	UpdatedAt    string                       `json:"updated_at"`
 }
 
 // ToTeamEntity converts CreateTeamRequest to entity.Team
-func (r *CreateTeamRequest) ToTeamEntity() *entity.Team {
-	return &entity.Team{
+func (r *CreateTeamRequest) ToTeamEntity() (*entity.Team, error) {
+	team := &entity.Team{
 		Name:        r.Name,
 		Logo:        r.Logo,
 		FoundedYear: r.FoundedYear,
 		Address:     r.Address,
 		City:        r.City,
 	}
+
+	if r.HomeVenueID != "" {
+		homeVenueID, err := uuid.Parse(r.HomeVenueID)
+		if err != nil {
+			return nil, err
+		}
+		team.HomeVenueID = &homeVenueID
+	}
+
+	return team, nil
 }
 
 // UpdateTeamEntity updates entity.Team with UpdateTeamRequest values
-func (r *UpdateTeamRequest) UpdateTeamEntity(team *entity.Team) {
+func (r *UpdateTeamRequest) UpdateTeamEntity(team *entity.Team) error {
 	if r.Name != "" {
 		team.Name = r.Name
 	}
@@ -64,6 +78,14 @@ func (r *UpdateTeamRequest) UpdateTeamEntity(team *entity.Team) {
 	if r.City != "" {
 		team.City = r.City
 	}
+	if r.HomeVenueID != "" {
+		homeVenueID, err := uuid.Parse(r.HomeVenueID)
+		if err != nil {
+			return err
+		}
+		team.HomeVenueID = &homeVenueID
+	}
+	return nil
 }
 
 // ToTeamResponse converts entity.Team to TeamResponse
@@ -79,6 +101,11 @@ func ToTeamResponse(team *entity.Team) TeamResponse {
 		UpdatedAt:   team.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 
+	if team.HomeVenueID != nil {
+		homeVenueID := team.HomeVenueID.String()
+		response.HomeVenueID = &homeVenueID
+	}
+
 	if team.Players != nil {
 		response.Players = make([]PlayerResponse, len(team.Players))
 		for i, player := range team.Players {
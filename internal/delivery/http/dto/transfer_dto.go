@@ -0,0 +1,141 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+// TransferRequest represents transfer player request body
+type TransferRequest struct {
+	ToTeamID     string  `json:"to_team_id" binding:"required,uuid"`
+	TransferDate string  `json:"transfer_date" binding:"required"` // Format: 2006-01-02
+	Fee          float64 `json:"fee" binding:"omitempty,min=0"`
+	IsLoan       bool    `json:"is_loan"`
+	JerseyNumber int     `json:"jersey_number" binding:"omitempty,min=1,max=99"` // Keeps the current number when empty
+}
+
+// TransferResponse represents transfer data in response
+type TransferResponse struct {
+	ID           string              `json:"id"`
+	PlayerID     string              `json:"player_id"`
+	FromTeamID   string              `json:"from_team_id"`
+	FromTeam     *TeamSimpleResponse `json:"from_team,omitempty"`
+	ToTeamID     string              `json:"to_team_id"`
+	ToTeam       *TeamSimpleResponse `json:"to_team,omitempty"`
+	TransferDate string              `json:"transfer_date"`
+	Fee          float64             `json:"fee"`
+	IsLoan       bool                `json:"is_loan"`
+	CreatedAt    string              `json:"created_at"`
+}
+
+// PlayerCareerResponse represents a player's career in response
+type PlayerCareerResponse struct {
+	Player      PlayerResponse        `json:"player"`
+	Appearances int                   `json:"appearances"`
+	Goals       int                   `json:"goals"`
+	Stints      []PlayerStintResponse `json:"stints"`
+	Transfers   []TransferResponse    `json:"transfers"`
+}
+
+// PlayerStintResponse represents a period at one team in response
+type PlayerStintResponse struct {
+	Team        *TeamSimpleResponse `json:"team,omitempty"`
+	From        *string             `json:"from"`
+	Until       *string             `json:"until"`
+	Fee         float64             `json:"fee"`
+	IsLoan      bool                `json:"is_loan"`
+	Appearances int                 `json:"appearances"`
+	Goals       int                 `json:"goals"`
+}
+
+// ToTransferInput converts TransferRequest to usecase.TransferInput
+func (r *TransferRequest) ToTransferInput() (*usecase.TransferInput, error) {
+	toTeamID, err := uuid.Parse(r.ToTeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	transferDate, err := time.Parse("2006-01-02", r.TransferDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase.TransferInput{
+		ToTeamID:     toTeamID,
+		TransferDate: transferDate,
+		Fee:          r.Fee,
+		IsLoan:       r.IsLoan,
+		JerseyNumber: r.JerseyNumber,
+	}, nil
+}
+
+// ToTransferResponse converts entity.Transfer to TransferResponse
+func ToTransferResponse(transfer *entity.Transfer) TransferResponse {
+	response := TransferResponse{
+		ID:           transfer.ID.String(),
+		PlayerID:     transfer.PlayerID.String(),
+		FromTeamID:   transfer.FromTeamID.String(),
+		ToTeamID:     transfer.ToTeamID.String(),
+		TransferDate: transfer.TransferDate.Format("2006-01-02"),
+		Fee:          transfer.Fee,
+		IsLoan:       transfer.IsLoan,
+		CreatedAt:    transfer.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if transfer.FromTeam != nil {
+		fromTeam := ToTeamSimpleResponse(transfer.FromTeam)
+		response.FromTeam = &fromTeam
+	}
+
+	if transfer.ToTeam != nil {
+		toTeam := ToTeamSimpleResponse(transfer.ToTeam)
+		response.ToTeam = &toTeam
+	}
+
+	return response
+}
+
+// ToPlayerCareerResponse converts usecase.PlayerCareer to PlayerCareerResponse
+func ToPlayerCareerResponse(career *usecase.PlayerCareer) PlayerCareerResponse {
+	response := PlayerCareerResponse{
+		Player:      ToPlayerResponse(career.Player),
+		Appearances: career.Appearances,
+		Goals:       career.Goals,
+		Stints:      make([]PlayerStintResponse, len(career.Stints)),
+		Transfers:   make([]TransferResponse, len(career.Transfers)),
+	}
+
+	for i, stint := range career.Stints {
+		stintResponse := PlayerStintResponse{
+			From:        formatOptionalDate(stint.From),
+			Until:       formatOptionalDate(stint.Until),
+			Fee:         stint.Fee,
+			IsLoan:      stint.IsLoan,
+			Appearances: stint.Appearances,
+			Goals:       stint.Goals,
+		}
+		if stint.Team != nil {
+			team := ToTeamSimpleResponse(stint.Team)
+			stintResponse.Team = &team
+		}
+		response.Stints[i] = stintResponse
+	}
+
+	for i, transfer := range career.Transfers {
+		response.Transfers[i] = ToTransferResponse(&transfer)
+	}
+
+	return response
+}
+
+// formatOptionalDate formats a date that may be missing
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// CreateVenueRequest represents create venue request body
+type CreateVenueRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=255"`
+	Address  string `json:"address" binding:"omitempty,max=500"`
+	City     string `json:"city" binding:"required,min=2,max=100"`
+	Capacity int    `json:"capacity" binding:"omitempty,min=0"`
+	Surface  string `json:"surface" binding:"omitempty,oneof=grass artificial hybrid"`
+}
+
+// UpdateVenueRequest represents update venue request body
+type UpdateVenueRequest struct {
+	Name     string `json:"name" binding:"omitempty,min=2,max=255"`
+	Address  string `json:"address" binding:"omitempty,max=500"`
+	City     string `json:"city" binding:"omitempty,min=2,max=100"`
+	Capacity *int   `json:"capacity" binding:"omitempty,min=0"`
+	Surface  string `json:"surface" binding:"omitempty,oneof=grass artificial hybrid"`
+}
+
+// VenueResponse represents venue data in response
+type VenueResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Address   string `json:"address"`
+	City      string `json:"city"`
+	Capacity  int    `json:"capacity"`
+	Surface   string `json:"surface"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// VenueSimpleResponse represents simplified venue data
+type VenueSimpleResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	City string `json:"city"`
+}
+
+// ToVenueEntity converts CreateVenueRequest to entity.Venue
+func (r *CreateVenueRequest) ToVenueEntity() *entity.Venue {
+	return &entity.Venue{
+		Name:     r.Name,
+		Address:  r.Address,
+		City:     r.City,
+		Capacity: r.Capacity,
+		Surface:  entity.VenueSurface(r.Surface),
+	}
+}
+
+// UpdateVenueEntity updates entity.Venue with UpdateVenueRequest values
+func (r *UpdateVenueRequest) UpdateVenueEntity(venue *entity.Venue) {
+	if r.Name != "" {
+		venue.Name = r.Name
+	}
+	if r.Address != "" {
+		venue.Address = r.Address
+	}
+	if r.City != "" {
+		venue.City = r.City
+	}
+	if r.Capacity != nil {
+		venue.Capacity = *r.Capacity
+	}
+	if r.Surface != "" {
+		venue.Surface = entity.VenueSurface(r.Surface)
+	}
+}
+
+// ToVenueResponse converts entity.Venue to VenueResponse
+func ToVenueResponse(venue *entity.Venue) VenueResponse {
+	return VenueResponse{
+		ID:        venue.ID.String(),
+		Name:      venue.Name,
+		Address:   venue.Address,
+		City:      venue.City,
+		Capacity:  venue.Capacity,
+		Surface:   string(venue.Surface),
+		CreatedAt: venue.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: venue.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ToVenueResponseList converts a slice of entity.Venue to VenueResponse slice
+func ToVenueResponseList(venues []entity.Venue) []VenueResponse {
+	responses := make([]VenueResponse, len(venues))
+	for i, venue := range venues {
+		responses[i] = ToVenueResponse(&venue)
+	}
+	return responses
+}
+
+// ToVenueSimpleResponse converts entity.Venue to VenueSimpleResponse
+func ToVenueSimpleResponse(venue *entity.Venue) VenueSimpleResponse {
+	return VenueSimpleResponse{
+		ID:   venue.ID.String(),
+		Name: venue.Name,
+		City: venue.City,
+	}
+}
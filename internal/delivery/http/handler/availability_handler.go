@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// AvailabilityHandler handles player injury and availability requests
+type AvailabilityHandler struct {
+	availabilityUseCase usecase.AvailabilityUseCase
+}
+
+// NewAvailabilityHandler creates a new instance of AvailabilityHandler
+func NewAvailabilityHandler(availabilityUseCase usecase.AvailabilityUseCase) *AvailabilityHandler {
+	return &AvailabilityHandler{availabilityUseCase: availabilityUseCase}
+}
+
+// GetAll handles getting a player's availability records
+// @Summary Get Player Availability
+// @Description Get a player's injury and availability records, newest first
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {object} response.Response{data=[]dto.PlayerAvailabilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/availability [get]
+func (h *AvailabilityHandler) GetAll(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	availabilities, err := h.availabilityUseCase.GetByPlayerID(c.Request.Context(), id)
+	if err != nil {
+		handleAvailabilityError(c, err, "Failed to get player availability")
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Player availability retrieved successfully", dto.ToPlayerAvailabilityResponseList(availabilities))
+}
+
+// Create handles marking a player unavailable
+// @Summary Mark Player Unavailable
+// @Description Record that a player is injured, ill or otherwise unavailable (Admin only)
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param request body dto.CreateAvailabilityRequest true "Availability details"
+// @Success 201 {object} response.Response{data=dto.PlayerAvailabilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/players/{id}/availability [post]
+func (h *AvailabilityHandler) Create(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	var req dto.CreateAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	availability, err := req.ToAvailabilityEntity(id)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.availabilityUseCase.Create(c.Request.Context(), availability); err != nil {
+		handleAvailabilityError(c, err, "Failed to create availability record")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Availability record created successfully", dto.ToPlayerAvailabilityResponse(availability))
+}
+
+// Close handles recording a player's return
+// @Summary Close Player Availability
+// @Description Record the date a player returned, closing the availability record (Admin only)
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param availability_id path string true "Availability ID"
+// @Param request body dto.CloseAvailabilityRequest false "Return date"
+// @Success 200 {object} response.Response{data=dto.PlayerAvailabilityResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/players/{id}/availability/{availability_id}/close [post]
+func (h *AvailabilityHandler) Close(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	availabilityID, err := uuid.Parse(c.Param("availability_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid availability ID", nil)
+		return
+	}
+
+	// The body is optional; the player returns today without one
+	var req dto.CloseAvailabilityRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	endDate, err := req.ToEndDate()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	availability, err := h.availabilityUseCase.Close(c.Request.Context(), id, availabilityID, endDate)
+	if err != nil {
+		handleAvailabilityError(c, err, "Failed to close availability record")
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Availability record closed successfully", dto.ToPlayerAvailabilityResponse(availability))
+}
+
+// handleAvailabilityError maps availability use case errors to responses
+func handleAvailabilityError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecase.ErrPlayerNotFound):
+		response.Error(c, http.StatusNotFound, "Player not found", nil)
+	case errors.Is(err, usecase.ErrAvailabilityNotFound):
+		response.Error(c, http.StatusNotFound, "Availability record not found", nil)
+	case errors.Is(err, usecase.ErrInvalidAvailabilityStatus),
+		errors.Is(err, usecase.ErrInvalidAvailabilityDates):
+		response.Error(c, http.StatusBadRequest, "Invalid availability record", err.Error())
+	case errors.Is(err, usecase.ErrAvailabilityOverlap),
+		errors.Is(err, usecase.ErrAvailabilityClosed):
+		response.Error(c, http.StatusConflict, "Availability record conflict", err.Error())
+	default:
+		response.Error(c, http.StatusInternalServerError, fallback, err.Error())
+	}
+}
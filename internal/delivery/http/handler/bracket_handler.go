@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// BracketHandler handles knockout bracket requests
+type BracketHandler struct {
+	bracketUseCase usecase.BracketUseCase
+}
+
+// NewBracketHandler creates a new instance of BracketHandler
+func NewBracketHandler(bracketUseCase usecase.BracketUseCase) *BracketHandler {
+	return &BracketHandler{bracketUseCase: bracketUseCase}
+}
+
+// Create handles bracket creation
+// @Summary Create Bracket
+// @Description Draw a seeded knockout bracket and schedule its first-round matches. Teams are listed in seed order.
+// @Tags Brackets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateBracketRequest true "Bracket details"
+// @Success 201 {object} response.Response{data=dto.BracketResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/brackets [post]
+func (h *BracketHandler) Create(c *gin.Context) {
+	var req dto.CreateBracketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, err := req.ToBracketInput()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	bracket, err := h.bracketUseCase.Create(c.Request.Context(), *input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "One or more teams not found", nil)
+		case errors.Is(err, usecase.ErrNotEnoughTeams),
+			errors.Is(err, usecase.ErrDuplicateTeam),
+			errors.Is(err, usecase.ErrInvalidKickoffTime),
+			errors.Is(err, usecase.ErrInvalidBracketSchedule):
+			response.Error(c, http.StatusBadRequest, "Invalid bracket options", err.Error())
+		case errors.Is(err, usecase.ErrVenueDoubleBooked), errors.Is(err, usecase.ErrTeamNotRested):
+			response.Error(c, http.StatusConflict, "Match clashes with another match", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to create bracket", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Bracket created successfully", dto.ToBracketResponse(bracket))
+}
+
+// GetByID handles getting a bracket with all of its ties
+// @Summary Get Bracket by ID
+// @Description Get a bracket with every tie, its legs and aggregate scores
+// @Tags Brackets
+// @Accept json
+// @Produce json
+// @Param id path string true "Bracket ID"
+// @Success 200 {object} response.Response{data=dto.BracketResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/brackets/{id} [get]
+func (h *BracketHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid bracket ID", nil)
+		return
+	}
+
+	bracket, err := h.bracketUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrBracketNotFound) {
+			response.Error(c, http.StatusNotFound, "Bracket not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get bracket", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Bracket retrieved successfully", dto.ToBracketResponse(bracket))
+}
+
+// GetAll handles getting all brackets with pagination
+// @Summary Get All Brackets
+// @Description Get all brackets with pagination
+// @Tags Brackets
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]dto.BracketResponse}
+// @Router /api/v1/brackets [get]
+func (h *BracketHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	brackets, total, err := h.bracketUseCase.GetAll(c.Request.Context(), page, limit)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get brackets", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Brackets retrieved successfully", dto.ToBracketResponseList(brackets), response.NewMeta(page, limit, total))
+}
+
+// Delete handles deleting a bracket
+// @Summary Delete Bracket
+// @Description Delete a bracket with its ties and scheduled matches (soft delete). Refused once any of its matches has kicked off.
+// @Tags Brackets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bracket ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/brackets/{id} [delete]
+func (h *BracketHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid bracket ID", nil)
+		return
+	}
+
+	if err := h.bracketUseCase.Delete(c.Request.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrBracketNotFound):
+			response.Error(c, http.StatusNotFound, "Bracket not found", nil)
+		case errors.Is(err, usecase.ErrBracketAlreadyPlayed):
+			response.Error(c, http.StatusConflict, "Bracket already has matches that have been played", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to delete bracket", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Bracket deleted successfully", nil)
+}
@@ -0,0 +1,434 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// CompetitionHandler handles competition and season related requests
+type CompetitionHandler struct {
+	competitionUseCase usecase.CompetitionUseCase
+}
+
+// NewCompetitionHandler creates a new instance of CompetitionHandler
+func NewCompetitionHandler(competitionUseCase usecase.CompetitionUseCase) *CompetitionHandler {
+	return &CompetitionHandler{competitionUseCase: competitionUseCase}
+}
+
+// Create handles competition creation
+// @Summary Create Competition
+// @Description Create a new competition (league or cup)
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateCompetitionRequest true "Competition details"
+// @Success 201 {object} response.Response{data=dto.CompetitionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/competitions [post]
+func (h *CompetitionHandler) Create(c *gin.Context) {
+	var req dto.CreateCompetitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	competition := req.ToCompetitionEntity()
+	if err := h.competitionUseCase.Create(c.Request.Context(), competition); err != nil {
+		if errors.Is(err, usecase.ErrInvalidCompetitionType) {
+			response.Error(c, http.StatusBadRequest, "Invalid competition type", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to create competition", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Competition created successfully", dto.ToCompetitionResponse(competition))
+}
+
+// GetByID handles getting a competition by ID
+// @Summary Get Competition
+// @Description Get a competition by ID
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param with_seasons query bool false "Include seasons"
+// @Success 200 {object} response.Response{data=dto.CompetitionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id} [get]
+func (h *CompetitionHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid competition ID", nil)
+		return
+	}
+
+	getCompetition := h.competitionUseCase.GetByID
+	if c.Query("with_seasons") == "true" {
+		getCompetition = h.competitionUseCase.GetByIDWithSeasons
+	}
+
+	competition, err := getCompetition(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCompetitionNotFound) {
+			response.Error(c, http.StatusNotFound, "Competition not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get competition", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Competition retrieved successfully", dto.ToCompetitionResponse(competition))
+}
+
+// Update handles updating a competition
+// @Summary Update Competition
+// @Description Update an existing competition
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param request body dto.UpdateCompetitionRequest true "Competition details"
+// @Success 200 {object} response.Response{data=dto.CompetitionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id} [put]
+func (h *CompetitionHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid competition ID", nil)
+		return
+	}
+
+	var req dto.UpdateCompetitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	competition, err := h.competitionUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCompetitionNotFound) {
+			response.Error(c, http.StatusNotFound, "Competition not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get competition", err.Error())
+		return
+	}
+
+	req.UpdateCompetitionEntity(competition)
+
+	if err := h.competitionUseCase.Update(c.Request.Context(), competition); err != nil {
+		if errors.Is(err, usecase.ErrInvalidCompetitionType) {
+			response.Error(c, http.StatusBadRequest, "Invalid competition type", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to update competition", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Competition updated successfully", dto.ToCompetitionResponse(competition))
+}
+
+// Delete handles deleting a competition
+// @Summary Delete Competition
+// @Description Delete a competition (soft delete)
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id} [delete]
+func (h *CompetitionHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid competition ID", nil)
+		return
+	}
+
+	if err := h.competitionUseCase.Delete(c.Request.Context(), id); err != nil {
+		if errors.Is(err, usecase.ErrCompetitionNotFound) {
+			response.Error(c, http.StatusNotFound, "Competition not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete competition", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Competition deleted successfully", nil)
+}
+
+// GetAll handles getting all competitions with pagination
+// @Summary Get All Competitions
+// @Description Get all competitions with pagination
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]dto.CompetitionResponse}
+// @Router /api/v1/competitions [get]
+func (h *CompetitionHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	competitions, total, err := h.competitionUseCase.GetAll(c.Request.Context(), page, limit)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get competitions", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Competitions retrieved successfully", dto.ToCompetitionResponseList(competitions), response.NewMeta(page, limit, total))
+}
+
+// CreateSeason handles season creation
+// @Summary Create Season
+// @Description Create a new season within a competition
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param request body dto.CreateSeasonRequest true "Season details"
+// @Success 201 {object} response.Response{data=dto.SeasonResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons [post]
+func (h *CompetitionHandler) CreateSeason(c *gin.Context) {
+	competitionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid competition ID", nil)
+		return
+	}
+
+	var req dto.CreateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	season, err := req.ToSeasonEntity(competitionID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.competitionUseCase.CreateSeason(c.Request.Context(), season); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrCompetitionNotFound):
+			response.Error(c, http.StatusNotFound, "Competition not found", nil)
+		case errors.Is(err, usecase.ErrInvalidSeasonDates):
+			response.Error(c, http.StatusBadRequest, "Season end date must not be before start date", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to create season", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Season created successfully", dto.ToSeasonResponse(season))
+}
+
+// GetSeasons handles getting all seasons of a competition
+// @Summary Get Seasons
+// @Description Get all seasons of a competition with pagination
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]dto.SeasonResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons [get]
+func (h *CompetitionHandler) GetSeasons(c *gin.Context) {
+	competitionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid competition ID", nil)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	seasons, total, err := h.competitionUseCase.GetSeasons(c.Request.Context(), competitionID, page, limit)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCompetitionNotFound) {
+			response.Error(c, http.StatusNotFound, "Competition not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get seasons", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Seasons retrieved successfully", dto.ToSeasonResponseList(seasons), response.NewMeta(page, limit, total))
+}
+
+// GetSeason handles getting a single season
+// @Summary Get Season
+// @Description Get a season of a competition by ID
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Success 200 {object} response.Response{data=dto.SeasonResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id} [get]
+func (h *CompetitionHandler) GetSeason(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	season, err := h.competitionUseCase.GetSeason(c.Request.Context(), competitionID, seasonID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get season", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Season retrieved successfully", dto.ToSeasonResponse(season))
+}
+
+// UpdateSeason handles updating a season
+// @Summary Update Season
+// @Description Update an existing season
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Param request body dto.UpdateSeasonRequest true "Season details"
+// @Success 200 {object} response.Response{data=dto.SeasonResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id} [put]
+func (h *CompetitionHandler) UpdateSeason(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	season, err := h.competitionUseCase.GetSeason(c.Request.Context(), competitionID, seasonID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get season", err.Error())
+		return
+	}
+
+	if err := req.UpdateSeasonEntity(season); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.competitionUseCase.UpdateSeason(c.Request.Context(), season); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrInvalidSeasonDates):
+			response.Error(c, http.StatusBadRequest, "Season end date must not be before start date", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to update season", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Season updated successfully", dto.ToSeasonResponse(season))
+}
+
+// DeleteSeason handles deleting a season
+// @Summary Delete Season
+// @Description Delete a season (soft delete)
+// @Tags Competitions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id} [delete]
+func (h *CompetitionHandler) DeleteSeason(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.competitionUseCase.DeleteSeason(c.Request.Context(), competitionID, seasonID); err != nil {
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete season", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Season deleted successfully", nil)
+}
+
+// parseSeasonPath parses the competition and season IDs from the request path.
+// It writes an error response and returns false when either ID is invalid.
+func parseSeasonPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	competitionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid competition ID", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	seasonID, err := uuid.Parse(c.Param("season_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid season ID", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return competitionID, seasonID, true
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// DisciplineHandler handles card and suspension requests
+type DisciplineHandler struct {
+	disciplineUseCase usecase.DisciplineUseCase
+}
+
+// NewDisciplineHandler creates a new instance of DisciplineHandler
+func NewDisciplineHandler(disciplineUseCase usecase.DisciplineUseCase) *DisciplineHandler {
+	return &DisciplineHandler{disciplineUseCase: disciplineUseCase}
+}
+
+// GetReport handles getting the disciplinary report
+// @Summary Get Discipline Report
+// @Description Get each player's cards and suspensions, with suspended players first
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Param team_id query string false "Only include players of this team"
+// @Success 200 {object} response.Response{data=[]dto.DisciplineRecordResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/reports/discipline [get]
+func (h *DisciplineHandler) GetReport(c *gin.Context) {
+	teamID, err := parseOptionalUUIDQuery(c, "team_id")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+		return
+	}
+
+	records, err := h.disciplineUseCase.GetReport(c.Request.Context(), teamID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get discipline report", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Discipline report retrieved successfully", dto.ToDisciplineRecordListResponse(records))
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// FixtureHandler handles fixture generation requests
+type FixtureHandler struct {
+	fixtureUseCase usecase.FixtureUseCase
+}
+
+// NewFixtureHandler creates a new instance of FixtureHandler
+func NewFixtureHandler(fixtureUseCase usecase.FixtureUseCase) *FixtureHandler {
+	return &FixtureHandler{fixtureUseCase: fixtureUseCase}
+}
+
+// GenerateRoundRobin handles round-robin fixture generation for a season
+// @Summary Generate Round-Robin Fixtures
+// @Description Generate a balanced round-robin schedule for a season. Use dry_run to preview without saving.
+// @Tags Fixtures
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Param request body dto.GenerateFixturesRequest true "Fixture options"
+// @Success 200 {object} response.Response{data=[]dto.FixtureRoundResponse}
+// @Success 201 {object} response.Response{data=[]dto.FixtureRoundResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id}/fixtures [post]
+func (h *FixtureHandler) GenerateRoundRobin(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.GenerateFixturesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, err := req.ToFixtureInput(competitionID, seasonID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	rounds, err := h.fixtureUseCase.GenerateRoundRobin(c.Request.Context(), *input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "One or more teams not found", nil)
+		case errors.Is(err, usecase.ErrNotEnoughTeams),
+			errors.Is(err, usecase.ErrDuplicateTeam),
+			errors.Is(err, usecase.ErrNoMatchDays),
+			errors.Is(err, usecase.ErrNoKickoffTimes),
+			errors.Is(err, usecase.ErrInvalidKickoffTime):
+			response.Error(c, http.StatusBadRequest, "Invalid fixture options", err.Error())
+		case errors.Is(err, usecase.ErrVenueDoubleBooked), errors.Is(err, usecase.ErrTeamNotRested):
+			response.Error(c, http.StatusConflict, "Match clashes with another match", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to generate fixtures", err.Error())
+		}
+		return
+	}
+
+	if input.DryRun {
+		response.Success(c, http.StatusOK, "Fixture preview generated successfully", dto.ToFixtureRoundResponseList(rounds))
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Fixtures generated successfully", dto.ToFixtureRoundResponseList(rounds))
+}
@@ -0,0 +1,320 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// GroupHandler handles group stage requests
+type GroupHandler struct {
+	groupUseCase usecase.GroupUseCase
+}
+
+// NewGroupHandler creates a new instance of GroupHandler
+func NewGroupHandler(groupUseCase usecase.GroupUseCase) *GroupHandler {
+	return &GroupHandler{groupUseCase: groupUseCase}
+}
+
+// Create handles group creation
+// @Summary Create Group
+// @Description Create a group of teams within a season
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Param request body dto.CreateGroupRequest true "Group details"
+// @Success 201 {object} response.Response{data=dto.GroupResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id}/groups [post]
+func (h *GroupHandler) Create(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	group, err := req.ToGroupEntity(seasonID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.groupUseCase.Create(c.Request.Context(), competitionID, group); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "One or more teams not found", nil)
+		case errors.Is(err, usecase.ErrTeamAlreadyInGroup):
+			response.Error(c, http.StatusConflict, "One or more teams are already in a group this season", nil)
+		case errors.Is(err, usecase.ErrNotEnoughTeams), errors.Is(err, usecase.ErrDuplicateTeam):
+			response.Error(c, http.StatusBadRequest, "Invalid group teams", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to create group", err.Error())
+		}
+		return
+	}
+
+	table, err := h.groupUseCase.GetTable(c.Request.Context(), competitionID, seasonID, group.ID)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get group", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Group created successfully", dto.ToGroupResponse(table))
+}
+
+// GetAll handles getting every group of a season with its table
+// @Summary Get Groups
+// @Description Get all groups of a season with their current tables
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Success 200 {object} response.Response{data=[]dto.GroupResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id}/groups [get]
+func (h *GroupHandler) GetAll(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	tables, err := h.groupUseCase.GetTables(c.Request.Context(), competitionID, seasonID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get groups", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Groups retrieved successfully", dto.ToGroupResponseList(tables))
+}
+
+// GetByID handles getting a single group with its table
+// @Summary Get Group by ID
+// @Description Get a group of a season with its current table
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Param group_id path string true "Group ID"
+// @Success 200 {object} response.Response{data=dto.GroupResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id}/groups/{group_id} [get]
+func (h *GroupHandler) GetByID(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("group_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid group ID", nil)
+		return
+	}
+
+	table, err := h.groupUseCase.GetTable(c.Request.Context(), competitionID, seasonID, groupID)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrGroupNotFound):
+			response.Error(c, http.StatusNotFound, "Group not found", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get group", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Group retrieved successfully", dto.ToGroupResponse(table))
+}
+
+// Delete handles deleting a group
+// @Summary Delete Group
+// @Description Delete a group (soft delete). Groups with matches cannot be deleted.
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Param group_id path string true "Group ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id}/groups/{group_id} [delete]
+func (h *GroupHandler) Delete(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("group_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid group ID", nil)
+		return
+	}
+
+	if err := h.groupUseCase.Delete(c.Request.Context(), competitionID, seasonID, groupID); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrGroupNotFound):
+			response.Error(c, http.StatusNotFound, "Group not found", nil)
+		case errors.Is(err, usecase.ErrGroupHasMatches):
+			response.Error(c, http.StatusConflict, "Group already has matches", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to delete group", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Group deleted successfully", nil)
+}
+
+// GenerateFixtures handles round-robin fixture generation for every group
+// @Summary Generate Group Stage Fixtures
+// @Description Generate a round-robin schedule inside every group of a season. Use dry_run to preview without saving.
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Param request body dto.GenerateGroupFixturesRequest true "Fixture options"
+// @Success 200 {object} response.Response{data=[]dto.FixtureRoundResponse}
+// @Success 201 {object} response.Response{data=[]dto.FixtureRoundResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id}/groups/fixtures [post]
+func (h *GroupHandler) GenerateFixtures(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.GenerateGroupFixturesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, err := req.ToFixtureInput(competitionID, seasonID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	rounds, err := h.groupUseCase.GenerateFixtures(c.Request.Context(), *input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrGroupHasMatches):
+			response.Error(c, http.StatusConflict, "One or more groups already have matches", nil)
+		case errors.Is(err, usecase.ErrNoGroups),
+			errors.Is(err, usecase.ErrNoMatchDays),
+			errors.Is(err, usecase.ErrNoKickoffTimes),
+			errors.Is(err, usecase.ErrInvalidKickoffTime):
+			response.Error(c, http.StatusBadRequest, "Invalid fixture options", err.Error())
+		case errors.Is(err, usecase.ErrVenueDoubleBooked), errors.Is(err, usecase.ErrTeamNotRested):
+			response.Error(c, http.StatusConflict, "Match clashes with another match", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to generate fixtures", err.Error())
+		}
+		return
+	}
+
+	if input.DryRun {
+		response.Success(c, http.StatusOK, "Fixture preview generated successfully", dto.ToFixtureRoundResponseList(rounds))
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Fixtures generated successfully", dto.ToFixtureRoundResponseList(rounds))
+}
+
+// GenerateKnockout handles drawing the knockout stage from the final group tables
+// @Summary Generate Knockout Stage
+// @Description Draw a knockout bracket from the final group positions once every group match is completed
+// @Tags Groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Competition ID"
+// @Param season_id path string true "Season ID"
+// @Param request body dto.GenerateKnockoutRequest true "Qualification and bracket options"
+// @Success 201 {object} response.Response{data=dto.BracketResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/competitions/{id}/seasons/{season_id}/knockout [post]
+func (h *GroupHandler) GenerateKnockout(c *gin.Context) {
+	competitionID, seasonID, ok := parseSeasonPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.GenerateKnockoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, err := req.ToKnockoutInput(competitionID, seasonID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	bracket, err := h.groupUseCase.GenerateKnockout(c.Request.Context(), *input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+		case errors.Is(err, usecase.ErrGroupStageIncomplete):
+			response.Error(c, http.StatusConflict, "All group matches must be completed first", nil)
+		case errors.Is(err, usecase.ErrKnockoutAlreadyGenerated):
+			response.Error(c, http.StatusConflict, "A knockout bracket already exists for this season", nil)
+		case errors.Is(err, usecase.ErrNoGroups),
+			errors.Is(err, usecase.ErrInvalidQualificationRules),
+			errors.Is(err, usecase.ErrInvalidKickoffTime),
+			errors.Is(err, usecase.ErrInvalidBracketSchedule):
+			response.Error(c, http.StatusBadRequest, "Invalid knockout options", err.Error())
+		case errors.Is(err, usecase.ErrVenueDoubleBooked), errors.Is(err, usecase.ErrTeamNotRested):
+			response.Error(c, http.StatusConflict, "Match clashes with another match", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to generate knockout stage", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Knockout stage generated successfully", dto.ToBracketResponse(bracket))
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// LineupHandler handles match lineup requests
+type LineupHandler struct {
+	lineupUseCase usecase.LineupUseCase
+}
+
+// NewLineupHandler creates a new instance of LineupHandler
+func NewLineupHandler(lineupUseCase usecase.LineupUseCase) *LineupHandler {
+	return &LineupHandler{lineupUseCase: lineupUseCase}
+}
+
+// GetAll handles getting the lineups of a match
+// @Summary Get Match Lineups
+// @Description Get the starting XI and bench each team named for a match
+// @Tags Lineups
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=[]dto.LineupResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/matches/{id}/lineups [get]
+func (h *LineupHandler) GetAll(c *gin.Context) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	lineups, err := h.lineupUseCase.GetByMatchID(c.Request.Context(), matchID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMatchNotFound) {
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get lineups", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Lineups retrieved successfully", dto.ToLineupResponseList(lineups))
+}
+
+// Save handles naming a team's lineup for a match
+// @Summary Save Lineup
+// @Description Name or replace a team's starting XI and bench for a match (Admin only)
+// @Tags Lineups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param team_id path string true "Team ID"
+// @Param request body dto.LineupRequest true "Lineup details"
+// @Success 200 {object} response.Response{data=dto.LineupResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/lineups/{team_id} [put]
+func (h *LineupHandler) Save(c *gin.Context) {
+	matchID, teamID, ok := parseLineupPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.LineupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	lineup, err := req.ToLineupEntity(matchID, teamID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.lineupUseCase.Save(c.Request.Context(), lineup); err != nil {
+		handleLineupError(c, err, "Failed to save lineup")
+		return
+	}
+
+	saved, err := h.lineupUseCase.GetByMatchAndTeam(c.Request.Context(), matchID, teamID)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get lineup", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Lineup saved successfully", dto.ToLineupResponse(saved))
+}
+
+// Delete handles removing a team's lineup from a match
+// @Summary Delete Lineup
+// @Description Remove a team's lineup from a match (Admin only)
+// @Tags Lineups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param team_id path string true "Team ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/matches/{id}/lineups/{team_id} [delete]
+func (h *LineupHandler) Delete(c *gin.Context) {
+	matchID, teamID, ok := parseLineupPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.lineupUseCase.Delete(c.Request.Context(), matchID, teamID); err != nil {
+		handleLineupError(c, err, "Failed to delete lineup")
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Lineup deleted successfully", nil)
+}
+
+// parseLineupPath parses the match and team IDs from the path, writing an
+// error response when either is invalid
+func parseLineupPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	teamID, err := uuid.Parse(c.Param("team_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return matchID, teamID, true
+}
+
+// handleLineupError maps lineup use case errors to responses
+func handleLineupError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecase.ErrMatchNotFound):
+		response.Error(c, http.StatusNotFound, "Match not found", nil)
+	case errors.Is(err, usecase.ErrLineupNotFound):
+		response.Error(c, http.StatusNotFound, "Lineup not found", nil)
+	case errors.Is(err, usecase.ErrPlayerNotFound):
+		response.Error(c, http.StatusNotFound, "Player not found", nil)
+	case errors.Is(err, usecase.ErrPlayerSuspended):
+		response.Error(c, http.StatusConflict, "Lineup names a suspended player", err.Error())
+	case errors.Is(err, usecase.ErrLineupTeamNotInMatch),
+		errors.Is(err, usecase.ErrLineupPlayerNotInTeam),
+		errors.Is(err, usecase.ErrDuplicateLineupPlayer),
+		errors.Is(err, usecase.ErrDuplicateJerseyNumber),
+		errors.Is(err, usecase.ErrInvalidStartingXI),
+		errors.Is(err, usecase.ErrTooManySubstitutes),
+		errors.Is(err, usecase.ErrInvalidLineupGoalkeeper),
+		errors.Is(err, usecase.ErrInvalidLineupCaptain),
+		errors.Is(err, usecase.ErrInvalidFormation):
+		response.Error(c, http.StatusBadRequest, "Invalid lineup", err.Error())
+	default:
+		response.Error(c, http.StatusInternalServerError, fallback, err.Error())
+	}
+}
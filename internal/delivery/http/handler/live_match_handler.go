@@ -0,0 +1,287 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// LiveMatchHandler handles requests for matches in progress
+type LiveMatchHandler struct {
+	liveMatchUseCase usecase.LiveMatchUseCase
+}
+
+// NewLiveMatchHandler creates a new instance of LiveMatchHandler
+func NewLiveMatchHandler(liveMatchUseCase usecase.LiveMatchUseCase) *LiveMatchHandler {
+	return &LiveMatchHandler{liveMatchUseCase: liveMatchUseCase}
+}
+
+// Kickoff handles starting a match
+// @Summary Kick Off Match
+// @Description Start a scheduled match and its first half clock (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/kickoff [post]
+func (h *LiveMatchHandler) Kickoff(c *gin.Context) {
+	h.transition(c, h.liveMatchUseCase.Kickoff, "Match kicked off")
+}
+
+// HalfTime handles stopping the clock at half time
+// @Summary Half Time
+// @Description End the first half of a live match (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/half-time [post]
+func (h *LiveMatchHandler) HalfTime(c *gin.Context) {
+	h.transition(c, h.liveMatchUseCase.StartHalfTime, "Half time started")
+}
+
+// SecondHalf handles restarting the clock for the second half
+// @Summary Second Half
+// @Description Start the second half of a live match (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/second-half [post]
+func (h *LiveMatchHandler) SecondHalf(c *gin.Context) {
+	h.transition(c, h.liveMatchUseCase.StartSecondHalf, "Second half started")
+}
+
+// FullTime handles stopping the clock at the end of regular or extra time
+// @Summary Full Time
+// @Description End play in a live match; the result stays open until the match is finished (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/full-time [post]
+func (h *LiveMatchHandler) FullTime(c *gin.Context) {
+	h.transition(c, h.liveMatchUseCase.EndRegularTime, "Full time reached")
+}
+
+// ExtraTime handles starting extra time
+// @Summary Extra Time
+// @Description Start extra time after full time (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/extra-time [post]
+func (h *LiveMatchHandler) ExtraTime(c *gin.Context) {
+	h.transition(c, h.liveMatchUseCase.StartExtraTime, "Extra time started")
+}
+
+// Finish handles freezing the result of a live match
+// @Summary Finish Match
+// @Description Complete a match at full time with the score derived from its goals (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param request body dto.FinishMatchRequest false "Penalty shootout result"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/finish [post]
+func (h *LiveMatchHandler) Finish(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	// The body is optional; it only carries a penalty shootout
+	var req dto.FinishMatchRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	match, err := h.liveMatchUseCase.Finish(c.Request.Context(), id, req.ToFinishInput())
+	if err != nil {
+		handleLiveMatchError(c, err, "Failed to finish match")
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match finished successfully", dto.ToMatchResponse(match))
+}
+
+// AddGoal handles adding a goal to a live match
+// @Summary Add Live Goal
+// @Description Add a goal while a match is in progress; the score is updated from the goals (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param request body dto.LiveGoalRequest true "Goal details"
+// @Success 201 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/goals [post]
+func (h *LiveMatchHandler) AddGoal(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	var req dto.LiveGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, err := req.ToGoalInput()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	match, err := h.liveMatchUseCase.AddGoal(c.Request.Context(), id, *input)
+	if err != nil {
+		handleLiveMatchError(c, err, "Failed to add goal")
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Goal added successfully", dto.ToMatchResponse(match))
+}
+
+// RemoveGoal handles removing a goal from a live match
+// @Summary Remove Live Goal
+// @Description Remove a goal while a match is in progress (Admin only)
+// @Tags Live Matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param goal_id path string true "Goal ID"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/goals/{goal_id} [delete]
+func (h *LiveMatchHandler) RemoveGoal(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	goalID, err := uuid.Parse(c.Param("goal_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid goal ID", nil)
+		return
+	}
+
+	match, err := h.liveMatchUseCase.RemoveGoal(c.Request.Context(), id, goalID)
+	if err != nil {
+		handleLiveMatchError(c, err, "Failed to remove goal")
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Goal removed successfully", dto.ToMatchResponse(match))
+}
+
+// transition runs a period change and writes the updated match
+func (h *LiveMatchHandler) transition(
+	c *gin.Context,
+	move func(ctx context.Context, matchID uuid.UUID) (*entity.Match, error),
+	message string,
+) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	match, err := move(c.Request.Context(), id)
+	if err != nil {
+		handleLiveMatchError(c, err, "Failed to update match period")
+		return
+	}
+
+	response.Success(c, http.StatusOK, message, dto.ToMatchResponse(match))
+}
+
+// handleLiveMatchError maps live match use case errors to responses
+func handleLiveMatchError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecase.ErrMatchNotFound):
+		response.Error(c, http.StatusNotFound, "Match not found", nil)
+	case errors.Is(err, usecase.ErrGoalNotFound):
+		response.Error(c, http.StatusNotFound, "Goal not found", nil)
+	case errors.Is(err, usecase.ErrPlayerNotFound):
+		response.Error(c, http.StatusNotFound, "Player not found", nil)
+	case errors.Is(err, usecase.ErrPlayerSuspended):
+		response.Error(c, http.StatusConflict, "Player is suspended", err.Error())
+	case errors.Is(err, usecase.ErrMatchNotLive),
+		errors.Is(err, usecase.ErrInvalidPeriodTransition),
+		errors.Is(err, usecase.ErrFirstLegNotPlayed),
+		errors.Is(err, usecase.ErrBracketAlreadyAdvanced):
+		response.Error(c, http.StatusConflict, "Match is not in the right state", err.Error())
+	case errors.Is(err, usecase.ErrVenueDoubleBooked), errors.Is(err, usecase.ErrTeamNotRested):
+		response.Error(c, http.StatusConflict, "Next round match clashes with another match", err.Error())
+	case errors.Is(err, usecase.ErrClockStopped),
+		errors.Is(err, usecase.ErrGoalTeamNotInMatch),
+		errors.Is(err, usecase.ErrScorerNotInTeam),
+		errors.Is(err, usecase.ErrScorerNotInLineup),
+		errors.Is(err, usecase.ErrPenaltyOwnGoal),
+		errors.Is(err, usecase.ErrInvalidPenalties),
+		errors.Is(err, usecase.ErrTieUndecided),
+		errors.Is(err, usecase.ErrUnexpectedPenalties):
+		response.Error(c, http.StatusBadRequest, "Invalid match data", err.Error())
+	default:
+		response.Error(c, http.StatusInternalServerError, fallback, err.Error())
+	}
+}
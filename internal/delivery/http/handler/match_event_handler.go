@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// MatchEventHandler handles match event requests
+type MatchEventHandler struct {
+	eventUseCase usecase.MatchEventUseCase
+}
+
+// NewMatchEventHandler creates a new instance of MatchEventHandler
+func NewMatchEventHandler(eventUseCase usecase.MatchEventUseCase) *MatchEventHandler {
+	return &MatchEventHandler{eventUseCase: eventUseCase}
+}
+
+// GetAll handles getting every event of a match
+// @Summary Get Match Events
+// @Description Get all cards, substitutions, assists and missed penalties of a match
+// @Tags Match Events
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=[]dto.MatchEventResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/matches/{id}/events [get]
+func (h *MatchEventHandler) GetAll(c *gin.Context) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	events, err := h.eventUseCase.GetByMatchID(c.Request.Context(), matchID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMatchNotFound) {
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get match events", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match events retrieved successfully", dto.ToMatchEventResponseList(events))
+}
+
+// Create handles adding an event to a match
+// @Summary Create Match Event
+// @Description Record a card, substitution, assist or missed penalty (Admin only)
+// @Tags Match Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param request body dto.MatchEventRequest true "Event details"
+// @Success 201 {object} response.Response{data=dto.MatchEventResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/events [post]
+func (h *MatchEventHandler) Create(c *gin.Context) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	var req dto.MatchEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	event, err := req.ToMatchEventEntity(matchID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.eventUseCase.Create(c.Request.Context(), event); err != nil {
+		handleMatchEventError(c, err, "Failed to create match event")
+		return
+	}
+
+	created, err := h.eventUseCase.GetByID(c.Request.Context(), matchID, event.ID)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get match event", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Match event created successfully", dto.ToMatchEventResponse(created))
+}
+
+// Update handles replacing an event of a match
+// @Summary Update Match Event
+// @Description Replace an existing match event (Admin only)
+// @Tags Match Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param event_id path string true "Event ID"
+// @Param request body dto.MatchEventRequest true "Event details"
+// @Success 200 {object} response.Response{data=dto.MatchEventResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/events/{event_id} [put]
+func (h *MatchEventHandler) Update(c *gin.Context) {
+	matchID, eventID, ok := parseMatchEventPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MatchEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	event, err := req.ToMatchEventEntity(matchID)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+	event.ID = eventID
+
+	if err := h.eventUseCase.Update(c.Request.Context(), event); err != nil {
+		handleMatchEventError(c, err, "Failed to update match event")
+		return
+	}
+
+	updated, err := h.eventUseCase.GetByID(c.Request.Context(), matchID, eventID)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get match event", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match event updated successfully", dto.ToMatchEventResponse(updated))
+}
+
+// Delete handles removing an event from a match
+// @Summary Delete Match Event
+// @Description Remove an event from a match (Admin only)
+// @Tags Match Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param event_id path string true "Event ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/events/{event_id} [delete]
+func (h *MatchEventHandler) Delete(c *gin.Context) {
+	matchID, eventID, ok := parseMatchEventPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.eventUseCase.Delete(c.Request.Context(), matchID, eventID); err != nil {
+		handleMatchEventError(c, err, "Failed to delete match event")
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match event deleted successfully", nil)
+}
+
+// parseMatchEventPath parses the match and event IDs from the path, writing
+// an error response when either is invalid
+func parseMatchEventPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	eventID, err := uuid.Parse(c.Param("event_id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid event ID", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return matchID, eventID, true
+}
+
+// handleMatchEventError maps match event use case errors to responses
+func handleMatchEventError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, usecase.ErrMatchNotFound):
+		response.Error(c, http.StatusNotFound, "Match not found", nil)
+	case errors.Is(err, usecase.ErrMatchEventNotFound):
+		response.Error(c, http.StatusNotFound, "Match event not found", nil)
+	case errors.Is(err, usecase.ErrPlayerNotFound):
+		response.Error(c, http.StatusNotFound, "Player not found", nil)
+	case errors.Is(err, usecase.ErrMatchNotStarted),
+		errors.Is(err, usecase.ErrAssistAlreadyRecorded),
+		errors.Is(err, usecase.ErrInvalidCardSequence),
+		errors.Is(err, usecase.ErrPlayerSentOff):
+		response.Error(c, http.StatusConflict, "Match event conflicts with the match", err.Error())
+	case errors.Is(err, usecase.ErrInvalidMatchEventType),
+		errors.Is(err, usecase.ErrEventMinuteRequired),
+		errors.Is(err, usecase.ErrEventTeamNotInMatch),
+		errors.Is(err, usecase.ErrPlayerNotInTeam),
+		errors.Is(err, usecase.ErrInvalidSubstitution),
+		errors.Is(err, usecase.ErrInvalidAssist):
+		response.Error(c, http.StatusBadRequest, "Invalid match event", err.Error())
+	default:
+		response.Error(c, http.StatusInternalServerError, fallback, err.Error())
+	}
+}
@@ -35,6 +35,8 @@ func NewMatchHandler(matchUseCase usecase.MatchUseCase) *MatchHandler {
 // @Success 201 {object} response.Response{data=dto.MatchResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
 // @Router /api/v1/matches [post]
 func (h *MatchHandler) Create(c *gin.Context) {
 	var req dto.CreateMatchRequest
@@ -54,6 +56,22 @@ func (h *MatchHandler) Create(c *gin.Context) {
 			response.Error(c, http.StatusBadRequest, "Home team and away team cannot be the same", nil)
 			return
 		}
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrInvalidMatchTime) {
+			response.Error(c, http.StatusBadRequest, "Match time must be in HH:MM format", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrVenueDoubleBooked) || errors.Is(err, usecase.ErrTeamNotRested) {
+			response.Error(c, http.StatusConflict, "Match clashes with another match", err.Error())
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to create match", err.Error())
 		return
 	}
@@ -105,6 +123,7 @@ func (h *MatchHandler) GetByID(c *gin.Context) {
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
 // @Router /api/v1/matches/{id} [put]
 func (h *MatchHandler) Update(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -139,6 +158,22 @@ func (h *MatchHandler) Update(c *gin.Context) {
 			response.Error(c, http.StatusBadRequest, "Home team and away team cannot be the same", nil)
 			return
 		}
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrInvalidMatchTime) {
+			response.Error(c, http.StatusBadRequest, "Match time must be in HH:MM format", nil)
+			return
+		}
+		if errors.Is(err, usecase.ErrVenueDoubleBooked) || errors.Is(err, usecase.ErrTeamNotRested) {
+			response.Error(c, http.StatusConflict, "Match clashes with another match", err.Error())
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to update match", err.Error())
 		return
 	}
@@ -187,6 +222,7 @@ func (h *MatchHandler) Delete(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
 // @Param team_id query string false "Filter by team ID"
+// @Param season_id query string false "Filter by season ID"
 // @Param status query string false "Filter by status (scheduled, ongoing, completed, cancelled)"
 // @Param start_date query string false "Start date filter (YYYY-MM-DD)"
 // @Param end_date query string false "End date filter (YYYY-MM-DD)"
@@ -196,6 +232,7 @@ func (h *MatchHandler) GetAll(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	teamIDStr := c.Query("team_id")
+	seasonIDStr := c.Query("season_id")
 	status := c.Query("status")
 	startDateStr := c.Query("start_date")
 	endDateStr := c.Query("end_date")
@@ -225,6 +262,20 @@ func (h *MatchHandler) GetAll(c *gin.Context) {
 			response.Error(c, http.StatusNotFound, "Team not found", nil)
 			return
 		}
+	} else if seasonIDStr != "" {
+		seasonID, parseErr := uuid.Parse(seasonIDStr)
+		if parseErr != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid season ID", nil)
+			return
+		}
+		m, totalCount, getErr := h.matchUseCase.GetBySeasonID(c.Request.Context(), seasonID, page, limit)
+		matches = dto.ToMatchResponseList(m)
+		total = totalCount
+		err = getErr
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
 	} else if status != "" {
 		m, totalCount, getErr := h.matchUseCase.GetByStatus(c.Request.Context(), entity.MatchStatus(status), page, limit)
 		matches = dto.ToMatchResponseList(m)
@@ -273,6 +324,7 @@ func (h *MatchHandler) GetAll(c *gin.Context) {
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
 // @Router /api/v1/matches/{id}/result [post]
 func (h *MatchHandler) RecordResult(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -287,46 +339,92 @@ func (h *MatchHandler) RecordResult(c *gin.Context) {
 		return
 	}
 
-	// Convert goals
+	input, ok := toMatchResultInput(c, &req)
+	if !ok {
+		return
+	}
+
+	match, err := h.matchUseCase.RecordResult(c.Request.Context(), id, input)
+	if err != nil {
+		respondRecordResultError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match result recorded successfully", dto.ToMatchResponse(match))
+}
+
+// toMatchResultInput converts a match result request, responding with 400
+// and returning false when a goal has an invalid ID
+func toMatchResultInput(c *gin.Context, req *dto.RecordMatchResultRequest) (usecase.MatchResultInput, bool) {
 	goals := make([]usecase.GoalInput, len(req.Goals))
 	for i, g := range req.Goals {
 		playerID, parseErr := uuid.Parse(g.PlayerID)
 		if parseErr != nil {
 			response.Error(c, http.StatusBadRequest, "Invalid player ID in goals", nil)
-			return
+			return usecase.MatchResultInput{}, false
 		}
 		teamID, parseErr := uuid.Parse(g.TeamID)
 		if parseErr != nil {
 			response.Error(c, http.StatusBadRequest, "Invalid team ID in goals", nil)
-			return
+			return usecase.MatchResultInput{}, false
 		}
 		goals[i] = usecase.GoalInput{
-			PlayerID:  playerID,
-			TeamID:    teamID,
-			Minute:    g.Minute,
-			IsOwnGoal: g.IsOwnGoal,
+			PlayerID:     playerID,
+			TeamID:       teamID,
+			Minute:       g.Minute,
+			StoppageTime: g.StoppageTime,
+			IsOwnGoal:    g.IsOwnGoal,
+			IsPenalty:    g.IsPenalty,
 		}
 	}
 
-	input := usecase.MatchResultInput{
-		HomeScore: req.HomeScore,
-		AwayScore: req.AwayScore,
-		Goals:     goals,
-	}
+	return usecase.MatchResultInput{
+		HomeScore:     req.HomeScore,
+		AwayScore:     req.AwayScore,
+		ExtraTime:     req.ExtraTime,
+		HomePenalties: req.HomePenalties,
+		AwayPenalties: req.AwayPenalties,
+		Goals:         goals,
+	}, true
+}
 
-	match, err := h.matchUseCase.RecordResult(c.Request.Context(), id, input)
-	if err != nil {
-		if errors.Is(err, usecase.ErrMatchNotFound) {
-			response.Error(c, http.StatusNotFound, "Match not found", nil)
-			return
-		}
-		if errors.Is(err, usecase.ErrPlayerNotFound) {
-			response.Error(c, http.StatusNotFound, "One or more players not found", nil)
-			return
-		}
-		response.Error(c, http.StatusInternalServerError, "Failed to record match result", err.Error())
+// respondRecordResultError responds to an error from recording a match result
+func respondRecordResultError(c *gin.Context, err error) {
+	if errors.Is(err, usecase.ErrMatchNotFound) {
+		response.Error(c, http.StatusNotFound, "Match not found", nil)
 		return
 	}
-
-	response.Success(c, http.StatusOK, "Match result recorded successfully", dto.ToMatchResponse(match))
+	if errors.Is(err, usecase.ErrPlayerNotFound) {
+		response.Error(c, http.StatusNotFound, "One or more players not found", nil)
+		return
+	}
+	if errors.Is(err, usecase.ErrInvalidPenalties) ||
+		errors.Is(err, usecase.ErrTieUndecided) ||
+		errors.Is(err, usecase.ErrUnexpectedPenalties) ||
+		errors.Is(err, usecase.ErrGoalTeamNotInMatch) ||
+		errors.Is(err, usecase.ErrScorerNotInTeam) ||
+		errors.Is(err, usecase.ErrScorerNotInLineup) ||
+		errors.Is(err, usecase.ErrGoalsDoNotMatchScore) ||
+		errors.Is(err, usecase.ErrPenaltyOwnGoal) {
+		response.Error(c, http.StatusBadRequest, "Invalid match result", err.Error())
+		return
+	}
+	if errors.Is(err, usecase.ErrFirstLegNotPlayed) || errors.Is(err, usecase.ErrBracketAlreadyAdvanced) {
+		response.Error(c, http.StatusConflict, "Match result conflicts with the bracket", err.Error())
+		return
+	}
+	if errors.Is(err, usecase.ErrPlayerSuspended) {
+		response.Error(c, http.StatusConflict, "Match result names a suspended player", err.Error())
+		return
+	}
+	if errors.Is(err, usecase.ErrMatchInProgress) {
+		response.Error(c, http.StatusConflict, "Match is in progress", err.Error())
+		return
+	}
+	// The next round of a bracket is scheduled when a tie is decided
+	if errors.Is(err, usecase.ErrVenueDoubleBooked) || errors.Is(err, usecase.ErrTeamNotRested) {
+		response.Error(c, http.StatusConflict, "Next round match clashes with another match", err.Error())
+		return
+	}
+	response.Error(c, http.StatusInternalServerError, "Failed to record match result", err.Error())
 }
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// streamKeepAliveInterval is how often an idle stream sends a comment so
+// proxies keep the connection open
+const streamKeepAliveInterval = 15 * time.Second
+
+// MatchStreamHandler handles live match streams over Server-Sent Events
+type MatchStreamHandler struct {
+	feed         usecase.MatchFeed
+	matchUseCase usecase.MatchUseCase
+}
+
+// NewMatchStreamHandler creates a new instance of MatchStreamHandler
+func NewMatchStreamHandler(feed usecase.MatchFeed, matchUseCase usecase.MatchUseCase) *MatchStreamHandler {
+	return &MatchStreamHandler{
+		feed:         feed,
+		matchUseCase: matchUseCase,
+	}
+}
+
+// Stream handles following a single match
+// @Summary Stream Match
+// @Description Server-Sent Events stream of a match, starting with a snapshot followed by score, goal and status updates
+// @Tags Matches
+// @Produce text/event-stream
+// @Param id path string true "Match ID"
+// @Success 200 {object} dto.MatchFeedEventResponse
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/matches/{id}/stream [get]
+func (h *MatchStreamHandler) Stream(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	match, err := h.matchUseCase.GetByIDWithDetails(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMatchNotFound) {
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get match", err.Error())
+		return
+	}
+
+	events, err := h.feed.Subscribe(c.Request.Context(), &id)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to follow match", err.Error())
+		return
+	}
+
+	snapshot := dto.ToMatchResponse(match)
+	h.serve(c, events, &snapshot)
+}
+
+// StreamAll handles following every match
+// @Summary Stream All Matches
+// @Description Server-Sent Events stream of score, goal and status updates for every match
+// @Tags Matches
+// @Produce text/event-stream
+// @Success 200 {object} dto.MatchFeedEventResponse
+// @Router /api/v1/matches/stream [get]
+func (h *MatchStreamHandler) StreamAll(c *gin.Context) {
+	events, err := h.feed.Subscribe(c.Request.Context(), nil)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to follow matches", err.Error())
+		return
+	}
+
+	h.serve(c, events, nil)
+}
+
+// serve writes feed events to the client until it disconnects or the feed
+// closes
+func (h *MatchStreamHandler) serve(c *gin.Context, events <-chan usecase.MatchFeedEvent, snapshot *dto.MatchResponse) {
+	// A stream outlives the server's write timeout
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	if snapshot != nil {
+		c.SSEvent("snapshot", snapshot)
+	}
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			c.SSEvent(string(event.Type), dto.ToMatchFeedEventResponse(&event))
+			c.Writer.Flush()
+		case <-keepAlive.C:
+			if _, err := c.Writer.WriteString(": keep-alive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
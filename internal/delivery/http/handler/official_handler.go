@@ -0,0 +1,482 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/middleware"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// OfficialHandler handles referee and match official related requests
+type OfficialHandler struct {
+	officialUseCase usecase.OfficialUseCase
+}
+
+// NewOfficialHandler creates a new instance of OfficialHandler
+func NewOfficialHandler(officialUseCase usecase.OfficialUseCase) *OfficialHandler {
+	return &OfficialHandler{officialUseCase: officialUseCase}
+}
+
+// Create handles official creation
+// @Summary Create Official
+// @Description Create a new referee or match official
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateOfficialRequest true "Official details"
+// @Success 201 {object} response.Response{data=dto.OfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/officials [post]
+func (h *OfficialHandler) Create(c *gin.Context) {
+	var req dto.CreateOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	official, err := req.ToOfficialEntity()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.officialUseCase.Create(c.Request.Context(), official); err != nil {
+		if errors.Is(err, usecase.ErrInvalidOfficialGrade) {
+			response.Error(c, http.StatusBadRequest, "Invalid official grade", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to create official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Official created successfully", dto.ToOfficialResponse(official))
+}
+
+// GetByID handles getting an official by ID
+// @Summary Get Official
+// @Description Get a match official by ID
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param id path string true "Official ID"
+// @Success 200 {object} response.Response{data=dto.OfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id} [get]
+func (h *OfficialHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	official, err := h.officialUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrOfficialNotFound) {
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official retrieved successfully", dto.ToOfficialResponse(official))
+}
+
+// Update handles updating an official
+// @Summary Update Official
+// @Description Update an existing match official
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Official ID"
+// @Param request body dto.UpdateOfficialRequest true "Official details"
+// @Success 200 {object} response.Response{data=dto.OfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id} [put]
+func (h *OfficialHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	var req dto.UpdateOfficialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	official, err := h.officialUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrOfficialNotFound) {
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get official", err.Error())
+		return
+	}
+
+	if err := req.UpdateOfficialEntity(official); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	if err := h.officialUseCase.Update(c.Request.Context(), official); err != nil {
+		if errors.Is(err, usecase.ErrInvalidOfficialGrade) {
+			response.Error(c, http.StatusBadRequest, "Invalid official grade", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to update official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official updated successfully", dto.ToOfficialResponse(official))
+}
+
+// Delete handles deleting an official
+// @Summary Delete Official
+// @Description Delete a match official (soft delete)
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Official ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id} [delete]
+func (h *OfficialHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	if err := h.officialUseCase.Delete(c.Request.Context(), id); err != nil {
+		if errors.Is(err, usecase.ErrOfficialNotFound) {
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete official", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official deleted successfully", nil)
+}
+
+// GetAll handles getting all officials with pagination
+// @Summary Get All Officials
+// @Description Get all match officials ordered by name with pagination
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]dto.OfficialResponse}
+// @Router /api/v1/officials [get]
+func (h *OfficialHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	officials, total, err := h.officialUseCase.GetAll(c.Request.Context(), page, limit)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get officials", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Officials retrieved successfully", dto.ToOfficialResponseList(officials), response.NewMeta(page, limit, total))
+}
+
+// CreateAccount handles creating an official's login account
+// @Summary Create Official Account
+// @Description Create the account a match official logs in with, with the official role
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Official ID"
+// @Param request body dto.CreateOfficialAccountRequest true "Account credentials"
+// @Success 201 {object} response.Response{data=dto.UserResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/officials/{id}/account [post]
+func (h *OfficialHandler) CreateAccount(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	var req dto.CreateOfficialAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	user, err := h.officialUseCase.CreateAccount(c.Request.Context(), id, req.Email, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrOfficialNotFound):
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+		case errors.Is(err, usecase.ErrOfficialHasAccount):
+			response.Error(c, http.StatusConflict, "Official already has an account", nil)
+		case errors.Is(err, usecase.ErrUserAlreadyExists):
+			response.Error(c, http.StatusConflict, "User with this email already exists", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to create official account", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Official account created successfully", dto.ToUserResponse(user))
+}
+
+// GetSchedule handles getting an official's schedule
+// @Summary Get Official Schedule
+// @Description Get the matches an official is assigned to in kickoff order, optionally within a date range
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param id path string true "Official ID"
+// @Param start_date query string false "Start date (YYYY-MM-DD)"
+// @Param end_date query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} response.Response{data=dto.OfficialScheduleResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/officials/{id}/schedule [get]
+func (h *OfficialHandler) GetSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid official ID", nil)
+		return
+	}
+
+	dateRange, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.officialUseCase.GetSchedule(c.Request.Context(), id, dateRange)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrOfficialNotFound):
+			response.Error(c, http.StatusNotFound, "Official not found", nil)
+		case errors.Is(err, usecase.ErrInvalidDateRange):
+			response.Error(c, http.StatusBadRequest, "Invalid date range", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get official schedule", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official schedule retrieved successfully", dto.ToOfficialScheduleResponse(schedule))
+}
+
+// GetMySchedule handles getting the logged in official's schedule
+// @Summary Get My Schedule
+// @Description Get the matches the logged in official is assigned to in kickoff order, optionally within a date range
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param start_date query string false "Start date (YYYY-MM-DD)"
+// @Param end_date query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} response.Response{data=dto.OfficialScheduleResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/v1/officials/me/schedule [get]
+func (h *OfficialHandler) GetMySchedule(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "User not authenticated", nil)
+		return
+	}
+
+	dateRange, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.officialUseCase.GetScheduleByUserID(c.Request.Context(), userID.(uuid.UUID), dateRange)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrOfficialNotFound):
+			response.Error(c, http.StatusForbidden, "No official is linked to this account", nil)
+		case errors.Is(err, usecase.ErrInvalidDateRange):
+			response.Error(c, http.StatusBadRequest, "Invalid date range", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get official schedule", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Official schedule retrieved successfully", dto.ToOfficialScheduleResponse(schedule))
+}
+
+// SubmitReport handles a match official submitting the match report
+// @Summary Submit Match Report
+// @Description Record the result of a match the logged in official is assigned to
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param request body dto.RecordMatchResultRequest true "Match result"
+// @Success 200 {object} response.Response{data=dto.MatchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/officials/me/matches/{id}/report [post]
+func (h *OfficialHandler) SubmitReport(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		response.Error(c, http.StatusUnauthorized, "User not authenticated", nil)
+		return
+	}
+
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	var req dto.RecordMatchResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, ok := toMatchResultInput(c, &req)
+	if !ok {
+		return
+	}
+
+	match, err := h.officialUseCase.SubmitReport(c.Request.Context(), userID.(uuid.UUID), matchID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrOfficialNotFound):
+			response.Error(c, http.StatusForbidden, "No official is linked to this account", nil)
+		case errors.Is(err, usecase.ErrOfficialNotAssigned):
+			response.Error(c, http.StatusForbidden, "Official is not assigned to this match", nil)
+		default:
+			respondRecordResultError(c, err)
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match report submitted successfully", dto.ToMatchResponse(match))
+}
+
+// GetMatchOfficials handles getting the officials assigned to a match
+// @Summary Get Match Officials
+// @Description Get the referee, assistants and fourth official assigned to a match
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=[]dto.MatchOfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/matches/{id}/officials [get]
+func (h *OfficialHandler) GetMatchOfficials(c *gin.Context) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	assignments, err := h.officialUseCase.GetMatchOfficials(c.Request.Context(), matchID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMatchNotFound) {
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get match officials", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match officials retrieved successfully", dto.ToMatchOfficialResponseList(assignments))
+}
+
+// AssignOfficials handles assigning officials to a match
+// @Summary Assign Match Officials
+// @Description Assign the referee, assistants and fourth official of a match, replacing any earlier assignment
+// @Tags Officials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param request body dto.AssignOfficialsRequest true "Officials"
+// @Success 200 {object} response.Response{data=[]dto.MatchOfficialResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/officials [put]
+func (h *OfficialHandler) AssignOfficials(c *gin.Context) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	var req dto.AssignOfficialsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	assignments, err := req.ToMatchOfficials()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	assignments, err = h.officialUseCase.AssignOfficials(c.Request.Context(), matchID, assignments)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrMatchNotFound):
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+		case errors.Is(err, usecase.ErrOfficialNotFound):
+			response.Error(c, http.StatusNotFound, "One or more officials not found", nil)
+		case errors.Is(err, usecase.ErrRefereeRequired),
+			errors.Is(err, usecase.ErrInvalidOfficialRole),
+			errors.Is(err, usecase.ErrDuplicateOfficialRole),
+			errors.Is(err, usecase.ErrDuplicateMatchOfficial),
+			errors.Is(err, usecase.ErrOfficialCertificationExpired):
+			response.Error(c, http.StatusBadRequest, "Invalid match officials", err.Error())
+		case errors.Is(err, usecase.ErrOfficialDoubleBooked), errors.Is(err, usecase.ErrMatchAlreadyPlayed):
+			response.Error(c, http.StatusConflict, "Match officials cannot be assigned", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to assign match officials", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match officials assigned successfully", dto.ToMatchOfficialResponseList(assignments))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
 	"github.com/zenkriztao/ayo-football-backend/pkg/response"
 )
@@ -202,6 +203,7 @@ func (h *PlayerHandler) Delete(c *gin.Context) {
 // @Param limit query int false "Items per page" default(10)
 // @Param search query string false "Search query"
 // @Param team_id query string false "Filter by team ID"
+// @Param available_on query string false "Only players available on this date (YYYY-MM-DD), requires team_id"
 // @Success 200 {object} response.Response{data=[]dto.PlayerResponse}
 // @Router /api/v1/players [get]
 func (h *PlayerHandler) GetAll(c *gin.Context) {
@@ -210,6 +212,16 @@ func (h *PlayerHandler) GetAll(c *gin.Context) {
 	search := c.Query("search")
 	teamIDStr := c.Query("team_id")
 
+	availableOn, err := parseOptionalDateQuery(c, "available_on")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid available_on date", nil)
+		return
+	}
+	if availableOn != nil && teamIDStr == "" {
+		response.Error(c, http.StatusBadRequest, "available_on requires team_id", nil)
+		return
+	}
+
 	if page < 1 {
 		page = 1
 	}
@@ -219,7 +231,6 @@ func (h *PlayerHandler) GetAll(c *gin.Context) {
 
 	var players interface{}
 	var total int64
-	var err error
 
 	if teamIDStr != "" {
 		teamID, parseErr := uuid.Parse(teamIDStr)
@@ -227,7 +238,14 @@ func (h *PlayerHandler) GetAll(c *gin.Context) {
 			response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
 			return
 		}
-		p, totalCount, getErr := h.playerUseCase.GetByTeamID(c.Request.Context(), teamID, page, limit)
+		var p []entity.Player
+		var totalCount int64
+		var getErr error
+		if availableOn != nil {
+			p, totalCount, getErr = h.playerUseCase.GetAvailableByTeamID(c.Request.Context(), teamID, *availableOn, page, limit)
+		} else {
+			p, totalCount, getErr = h.playerUseCase.GetByTeamID(c.Request.Context(), teamID, page, limit)
+		}
 		players = dto.ToPlayerResponseList(p)
 		total = totalCount
 		err = getErr
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// PredictionHandler handles match prediction requests
+type PredictionHandler struct {
+	predictionUseCase usecase.PredictionUseCase
+}
+
+// NewPredictionHandler creates a new instance of PredictionHandler
+func NewPredictionHandler(predictionUseCase usecase.PredictionUseCase) *PredictionHandler {
+	return &PredictionHandler{predictionUseCase: predictionUseCase}
+}
+
+// GetPrediction handles predicting a scheduled match
+// @Summary Get Match Prediction
+// @Description Get home/draw/away probabilities and the expected scoreline of a scheduled match from a Poisson model of completed results
+// @Tags Matches
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} response.Response{data=dto.MatchPredictionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/matches/{id}/prediction [get]
+func (h *PredictionHandler) GetPrediction(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid match ID", nil)
+		return
+	}
+
+	prediction, err := h.predictionUseCase.Predict(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrMatchNotFound):
+			response.Error(c, http.StatusNotFound, "Match not found", nil)
+		case errors.Is(err, usecase.ErrMatchNotScheduled):
+			response.Error(c, http.StatusConflict, "Match is not in the right state", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to predict match", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match prediction retrieved successfully", dto.ToMatchPredictionResponse(prediction))
+}
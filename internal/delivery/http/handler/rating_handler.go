@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// RatingHandler handles Elo team rating requests
+type RatingHandler struct {
+	ratingUseCase usecase.RatingUseCase
+}
+
+// NewRatingHandler creates a new instance of RatingHandler
+func NewRatingHandler(ratingUseCase usecase.RatingUseCase) *RatingHandler {
+	return &RatingHandler{ratingUseCase: ratingUseCase}
+}
+
+// GetRatings handles getting the team rating table
+// @Summary Get Team Ratings
+// @Description Get every team's Elo rating from completed matches, highest first
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response{data=[]dto.TeamRatingResponse}
+// @Router /api/v1/reports/ratings [get]
+func (h *RatingHandler) GetRatings(c *gin.Context) {
+	ratings, err := h.ratingUseCase.GetRatings(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get ratings", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Ratings retrieved successfully", dto.ToTeamRatingResponseList(ratings))
+}
+
+// GetHistory handles getting a team's rating history
+// @Summary Get Team Rating History
+// @Description Get a team's current Elo rating and the change from each completed match
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param id path string true "Team ID"
+// @Success 200 {object} response.Response{data=dto.TeamRatingHistoryResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/teams/{id}/rating-history [get]
+func (h *RatingHandler) GetHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+		return
+	}
+
+	history, err := h.ratingUseCase.GetHistory(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTeamNotFound) {
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get rating history", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Rating history retrieved successfully", dto.ToTeamRatingHistoryResponse(history))
+}
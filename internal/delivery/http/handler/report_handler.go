@@ -55,13 +55,15 @@ func (h *ReportHandler) GetMatchReport(c *gin.Context) {
 
 // GetAllMatchReports handles getting all match reports
 // @Summary Get All Match Reports
-// @Description Get reports for all completed matches with pagination
+// @Description Get reports for all completed matches with pagination; the meta holds the top scorer across every page
 // @Tags Reports
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
+// @Param season_id query string false "Filter by season ID"
 // @Success 200 {object} response.Response{data=[]dto.MatchReportResponse}
+// @Failure 404 {object} response.Response
 // @Router /api/v1/reports/matches [get]
 func (h *ReportHandler) GetAllMatchReports(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
@@ -74,13 +76,23 @@ func (h *ReportHandler) GetAllMatchReports(c *gin.Context) {
 		limit = 10
 	}
 
-	reports, total, err := h.reportUseCase.GetAllMatchReports(c.Request.Context(), page, limit)
+	seasonID, err := parseOptionalUUIDQuery(c, "season_id")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid season ID", nil)
+		return
+	}
+
+	reportPage, err := h.reportUseCase.GetAllMatchReports(c.Request.Context(), seasonID, page, limit)
 	if err != nil {
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to get match reports", err.Error())
 		return
 	}
 
-	response.SuccessWithMeta(c, http.StatusOK, "Match reports retrieved successfully", dto.ToMatchReportResponseList(reports), response.NewMeta(page, limit, total))
+	response.SuccessWithMeta(c, http.StatusOK, "Match reports retrieved successfully", dto.ToMatchReportResponseList(reportPage.Reports), dto.ToMatchReportListMeta(reportPage, page, limit))
 }
 
 // GetTopScorers handles getting top scorers
@@ -90,7 +102,9 @@ func (h *ReportHandler) GetAllMatchReports(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param limit query int false "Number of top scorers to return" default(10)
+// @Param season_id query string false "Filter by season ID"
 // @Success 200 {object} response.Response{data=[]dto.TopScorerResponse}
+// @Failure 404 {object} response.Response
 // @Router /api/v1/reports/top-scorers [get]
 func (h *ReportHandler) GetTopScorers(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -99,11 +113,122 @@ func (h *ReportHandler) GetTopScorers(c *gin.Context) {
 		limit = 10
 	}
 
-	scorers, err := h.reportUseCase.GetTopScorers(c.Request.Context(), limit)
+	seasonID, err := parseOptionalUUIDQuery(c, "season_id")
 	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid season ID", nil)
+		return
+	}
+
+	scorers, err := h.reportUseCase.GetTopScorers(c.Request.Context(), seasonID, limit)
+	if err != nil {
+		if errors.Is(err, usecase.ErrSeasonNotFound) {
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to get top scorers", err.Error())
 		return
 	}
 
 	response.Success(c, http.StatusOK, "Top scorers retrieved successfully", dto.ToTopScorerResponseList(scorers))
 }
+
+// GetStandings handles getting the league standings table
+// @Summary Get Standings
+// @Description Get the league table computed from all completed matches
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Param win_points query int false "Points for a win" default(3)
+// @Param draw_points query int false "Points for a draw" default(1)
+// @Param loss_points query int false "Points for a loss" default(0)
+// @Param season_id query string false "Filter by season ID"
+// @Success 200 {object} response.Response{data=[]dto.StandingResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/reports/standings [get]
+func (h *ReportHandler) GetStandings(c *gin.Context) {
+	points := usecase.DefaultPointsSystem()
+
+	// Checked in a fixed order so the first invalid parameter is reported
+	for _, param := range []struct {
+		name   string
+		target *int64
+	}{
+		{"win_points", &points.Win},
+		{"draw_points", &points.Draw},
+		{"loss_points", &points.Loss},
+	} {
+		value := c.Query(param.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid "+param.name+" value", nil)
+			return
+		}
+		*param.target = parsed
+	}
+
+	seasonID, err := parseOptionalUUIDQuery(c, "season_id")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid season ID", nil)
+		return
+	}
+
+	standings, err := h.reportUseCase.GetStandings(c.Request.Context(), seasonID, points)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidPointsSystem):
+			response.Error(c, http.StatusBadRequest, "Invalid points system", err.Error())
+			return
+		case errors.Is(err, usecase.ErrSeasonNotFound):
+			response.Error(c, http.StatusNotFound, "Season not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get standings", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Standings retrieved successfully", dto.ToStandingResponseList(standings))
+}
+
+// GetHeadToHead handles getting two teams' record against each other
+// @Summary Get Head-to-Head
+// @Description Get every completed meeting of two teams, with either side at home, plus wins, goals, the largest result and top scorers
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Param team_a query string true "First team ID"
+// @Param team_b query string true "Second team ID"
+// @Success 200 {object} response.Response{data=dto.HeadToHeadResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/reports/head-to-head [get]
+func (h *ReportHandler) GetHeadToHead(c *gin.Context) {
+	teamA, err := uuid.Parse(c.Query("team_a"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team_a ID", nil)
+		return
+	}
+	teamB, err := uuid.Parse(c.Query("team_b"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team_b ID", nil)
+		return
+	}
+
+	record, err := h.reportUseCase.GetHeadToHead(c.Request.Context(), teamA, teamB)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrHeadToHeadSameTeam):
+			response.Error(c, http.StatusBadRequest, "Invalid teams", err.Error())
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get head-to-head", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Head-to-head retrieved successfully", dto.ToHeadToHeadResponse(record))
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// StatsHandler handles player and team statistics requests
+type StatsHandler struct {
+	statsUseCase usecase.StatsUseCase
+}
+
+// NewStatsHandler creates a new instance of StatsHandler
+func NewStatsHandler(statsUseCase usecase.StatsUseCase) *StatsHandler {
+	return &StatsHandler{statsUseCase: statsUseCase}
+}
+
+// GetPlayerStats handles getting a player's scoring statistics
+// @Summary Get Player Stats
+// @Description Get a player's goals in completed matches, split by minute, home/away and opponent
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param start_date query string false "Only matches on or after this date (YYYY-MM-DD)"
+// @Param end_date query string false "Only matches on or before this date (YYYY-MM-DD)"
+// @Success 200 {object} response.Response{data=dto.PlayerStatsResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/stats [get]
+func (h *StatsHandler) GetPlayerStats(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	dateRange, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.statsUseCase.GetPlayerStats(c.Request.Context(), id, dateRange)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrPlayerNotFound):
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+		case errors.Is(err, usecase.ErrInvalidDateRange):
+			response.Error(c, http.StatusBadRequest, "Invalid date range", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get player stats", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Player stats retrieved successfully", dto.ToPlayerStatsResponse(stats))
+}
+
+// GetTeamStats handles getting a team's statistics
+// @Summary Get Team Stats
+// @Description Get a team's home/away record, biggest results, streaks and last-5 form from completed matches
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param id path string true "Team ID"
+// @Param start_date query string false "Only matches on or after this date (YYYY-MM-DD)"
+// @Param end_date query string false "Only matches on or before this date (YYYY-MM-DD)"
+// @Success 200 {object} response.Response{data=dto.TeamStatsResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/teams/{id}/stats [get]
+func (h *StatsHandler) GetTeamStats(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", nil)
+		return
+	}
+
+	dateRange, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.statsUseCase.GetTeamStats(c.Request.Context(), id, dateRange)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+		case errors.Is(err, usecase.ErrInvalidDateRange):
+			response.Error(c, http.StatusBadRequest, "Invalid date range", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get team stats", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Team stats retrieved successfully", dto.ToTeamStatsResponse(stats))
+}
+
+// parseDateRange reads the optional start_date and end_date query parameters,
+// writing a 400 response when either is malformed
+func parseDateRange(c *gin.Context) (usecase.DateRange, bool) {
+	from, err := parseOptionalDateQuery(c, "start_date")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid start date format", nil)
+		return usecase.DateRange{}, false
+	}
+	to, err := parseOptionalDateQuery(c, "end_date")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid end date format", nil)
+		return usecase.DateRange{}, false
+	}
+	return usecase.DateRange{From: from, To: to}, true
+}
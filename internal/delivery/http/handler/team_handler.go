@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -14,12 +15,16 @@ import (
 
 // TeamHandler handles team related requests
 type TeamHandler struct {
-	teamUseCase usecase.TeamUseCase
+	teamUseCase         usecase.TeamUseCase
+	availabilityUseCase usecase.AvailabilityUseCase
 }
 
 // NewTeamHandler creates a new instance of TeamHandler
-func NewTeamHandler(teamUseCase usecase.TeamUseCase) *TeamHandler {
-	return &TeamHandler{teamUseCase: teamUseCase}
+func NewTeamHandler(teamUseCase usecase.TeamUseCase, availabilityUseCase usecase.AvailabilityUseCase) *TeamHandler {
+	return &TeamHandler{
+		teamUseCase:         teamUseCase,
+		availabilityUseCase: availabilityUseCase,
+	}
 }
 
 // Create handles team creation
@@ -33,6 +38,7 @@ func NewTeamHandler(teamUseCase usecase.TeamUseCase) *TeamHandler {
 // @Success 201 {object} response.Response{data=dto.TeamResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
 // @Router /api/v1/teams [post]
 func (h *TeamHandler) Create(c *gin.Context) {
 	var req dto.CreateTeamRequest
@@ -41,8 +47,17 @@ func (h *TeamHandler) Create(c *gin.Context) {
 		return
 	}
 
-	team := req.ToTeamEntity()
+	team, err := req.ToTeamEntity()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
 	if err := h.teamUseCase.Create(c.Request.Context(), team); err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to create team", err.Error())
 		return
 	}
@@ -52,11 +67,12 @@ func (h *TeamHandler) Create(c *gin.Context) {
 
 // GetByID handles getting a team by ID
 // @Summary Get Team
-// @Description Get a team by ID
+// @Description Get a team by ID. With with_players=true the team's players and the players currently unavailable are included
 // @Tags Teams
 // @Accept json
 // @Produce json
 // @Param id path string true "Team ID"
+// @Param with_players query bool false "Include players and availability"
 // @Success 200 {object} response.Response{data=dto.TeamResponse}
 // @Failure 400 {object} response.Response
 // @Failure 404 {object} response.Response
@@ -82,7 +98,17 @@ func (h *TeamHandler) GetByID(c *gin.Context) {
 			response.Error(c, http.StatusInternalServerError, "Failed to get team", err.Error())
 			return
 		}
-		team = dto.ToTeamResponse(t)
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		unavailable, err := h.availabilityUseCase.GetUnavailableByTeamID(c.Request.Context(), id, today)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, "Failed to get team availability", err.Error())
+			return
+		}
+
+		teamResponse := dto.ToTeamResponse(t)
+		teamResponse.Availability = dto.ToPlayerAvailabilityResponseList(unavailable)
+		team = teamResponse
 	} else {
 		t, err := h.teamUseCase.GetByID(c.Request.Context(), id)
 		if err != nil {
@@ -136,9 +162,16 @@ func (h *TeamHandler) Update(c *gin.Context) {
 		return
 	}
 
-	req.UpdateTeamEntity(team)
+	if err := req.UpdateTeamEntity(team); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
 
 	if err := h.teamUseCase.Update(c.Request.Context(), team); err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Failed to update team", err.Error())
 		return
 	}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// TransferHandler handles player transfer and career requests
+type TransferHandler struct {
+	transferUseCase usecase.TransferUseCase
+}
+
+// NewTransferHandler creates a new instance of TransferHandler
+func NewTransferHandler(transferUseCase usecase.TransferUseCase) *TransferHandler {
+	return &TransferHandler{transferUseCase: transferUseCase}
+}
+
+// Transfer handles moving a player to another team
+// @Summary Transfer Player
+// @Description Move a player to another team and record the transfer (Admin only)
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Player ID"
+// @Param request body dto.TransferRequest true "Transfer details"
+// @Success 201 {object} response.Response{data=dto.TransferResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/v1/players/{id}/transfers [post]
+func (h *TransferHandler) Transfer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	var req dto.TransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	input, err := req.ToTransferInput()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request data", err.Error())
+		return
+	}
+
+	transfer, err := h.transferUseCase.Transfer(c.Request.Context(), id, *input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrPlayerNotFound):
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+		case errors.Is(err, usecase.ErrTeamNotFound):
+			response.Error(c, http.StatusNotFound, "Team not found", nil)
+		case errors.Is(err, usecase.ErrJerseyNumberTaken):
+			response.Error(c, http.StatusConflict, "Jersey number is already taken by another player in this team", nil)
+		case errors.Is(err, usecase.ErrTransferToSameTeam),
+			errors.Is(err, usecase.ErrTransferDateInFuture),
+			errors.Is(err, usecase.ErrTransferDateBeforeLast),
+			errors.Is(err, usecase.ErrInvalidJerseyNumber):
+			response.Error(c, http.StatusBadRequest, "Invalid transfer", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to transfer player", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Player transferred successfully", dto.ToTransferResponse(transfer))
+}
+
+// GetCareer handles getting the teams a player has played for
+// @Summary Get Player Career
+// @Description Get a player's stints at each team with appearances and goals per stint
+// @Tags Players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {object} response.Response{data=dto.PlayerCareerResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/players/{id}/career [get]
+func (h *TransferHandler) GetCareer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid player ID", nil)
+		return
+	}
+
+	career, err := h.transferUseCase.GetCareer(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrPlayerNotFound) {
+			response.Error(c, http.StatusNotFound, "Player not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get player career", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Player career retrieved successfully", dto.ToPlayerCareerResponse(career))
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/delivery/http/dto"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/pkg/response"
+)
+
+// VenueHandler handles venue related requests
+type VenueHandler struct {
+	venueUseCase usecase.VenueUseCase
+}
+
+// NewVenueHandler creates a new instance of VenueHandler
+func NewVenueHandler(venueUseCase usecase.VenueUseCase) *VenueHandler {
+	return &VenueHandler{venueUseCase: venueUseCase}
+}
+
+// Create handles venue creation
+// @Summary Create Venue
+// @Description Create a new venue
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateVenueRequest true "Venue details"
+// @Success 201 {object} response.Response{data=dto.VenueResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/venues [post]
+func (h *VenueHandler) Create(c *gin.Context) {
+	var req dto.CreateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	venue := req.ToVenueEntity()
+	if err := h.venueUseCase.Create(c.Request.Context(), venue); err != nil {
+		if errors.Is(err, usecase.ErrInvalidVenueSurface) {
+			response.Error(c, http.StatusBadRequest, "Invalid venue surface", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to create venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Venue created successfully", dto.ToVenueResponse(venue))
+}
+
+// GetByID handles getting a venue by ID
+// @Summary Get Venue
+// @Description Get a venue by ID
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param id path string true "Venue ID"
+// @Success 200 {object} response.Response{data=dto.VenueResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/venues/{id} [get]
+func (h *VenueHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid venue ID", nil)
+		return
+	}
+
+	venue, err := h.venueUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Venue retrieved successfully", dto.ToVenueResponse(venue))
+}
+
+// Update handles updating a venue
+// @Summary Update Venue
+// @Description Update an existing venue
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Venue ID"
+// @Param request body dto.UpdateVenueRequest true "Venue details"
+// @Success 200 {object} response.Response{data=dto.VenueResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/venues/{id} [put]
+func (h *VenueHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid venue ID", nil)
+		return
+	}
+
+	var req dto.UpdateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	venue, err := h.venueUseCase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get venue", err.Error())
+		return
+	}
+
+	req.UpdateVenueEntity(venue)
+
+	if err := h.venueUseCase.Update(c.Request.Context(), venue); err != nil {
+		if errors.Is(err, usecase.ErrInvalidVenueSurface) {
+			response.Error(c, http.StatusBadRequest, "Invalid venue surface", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to update venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Venue updated successfully", dto.ToVenueResponse(venue))
+}
+
+// Delete handles deleting a venue
+// @Summary Delete Venue
+// @Description Delete a venue (soft delete)
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Venue ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/venues/{id} [delete]
+func (h *VenueHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid venue ID", nil)
+		return
+	}
+
+	if err := h.venueUseCase.Delete(c.Request.Context(), id); err != nil {
+		if errors.Is(err, usecase.ErrVenueNotFound) {
+			response.Error(c, http.StatusNotFound, "Venue not found", nil)
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to delete venue", err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Venue deleted successfully", nil)
+}
+
+// GetAll handles getting all venues with pagination
+// @Summary Get All Venues
+// @Description Get all venues ordered by name with pagination
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]dto.VenueResponse}
+// @Router /api/v1/venues [get]
+func (h *VenueHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	venues, total, err := h.venueUseCase.GetAll(c.Request.Context(), page, limit)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get venues", err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, http.StatusOK, "Venues retrieved successfully", dto.ToVenueResponseList(venues), response.NewMeta(page, limit, total))
+}
@@ -70,3 +70,23 @@ func AdminMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// OfficialMiddleware creates match official-only middleware
+func OfficialMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, exists := c.Get(UserRoleKey)
+		if !exists {
+			response.Error(c, http.StatusUnauthorized, "User role not found", nil)
+			c.Abort()
+			return
+		}
+
+		if role != string(entity.RoleOfficial) {
+			response.Error(c, http.StatusForbidden, "Match official access required", nil)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
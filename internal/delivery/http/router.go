@@ -9,12 +9,28 @@ import (
 
 // Router holds all HTTP handlers
 type Router struct {
-	authHandler   *handler.AuthHandler
-	teamHandler   *handler.TeamHandler
-	playerHandler *handler.PlayerHandler
-	matchHandler  *handler.MatchHandler
-	reportHandler *handler.ReportHandler
-	jwtService    security.JWTService
+	authHandler         *handler.AuthHandler
+	teamHandler         *handler.TeamHandler
+	playerHandler       *handler.PlayerHandler
+	matchHandler        *handler.MatchHandler
+	reportHandler       *handler.ReportHandler
+	competitionHandler  *handler.CompetitionHandler
+	fixtureHandler      *handler.FixtureHandler
+	bracketHandler      *handler.BracketHandler
+	groupHandler        *handler.GroupHandler
+	matchEventHandler   *handler.MatchEventHandler
+	liveMatchHandler    *handler.LiveMatchHandler
+	matchStreamHandler  *handler.MatchStreamHandler
+	lineupHandler       *handler.LineupHandler
+	transferHandler     *handler.TransferHandler
+	disciplineHandler   *handler.DisciplineHandler
+	availabilityHandler *handler.AvailabilityHandler
+	statsHandler        *handler.StatsHandler
+	ratingHandler       *handler.RatingHandler
+	predictionHandler   *handler.PredictionHandler
+	venueHandler        *handler.VenueHandler
+	officialHandler     *handler.OfficialHandler
+	jwtService          security.JWTService
 }
 
 // NewRouter creates a new Router instance
@@ -24,15 +40,47 @@ func NewRouter(
 	playerHandler *handler.PlayerHandler,
 	matchHandler *handler.MatchHandler,
 	reportHandler *handler.ReportHandler,
+	competitionHandler *handler.CompetitionHandler,
+	fixtureHandler *handler.FixtureHandler,
+	bracketHandler *handler.BracketHandler,
+	groupHandler *handler.GroupHandler,
+	matchEventHandler *handler.MatchEventHandler,
+	liveMatchHandler *handler.LiveMatchHandler,
+	matchStreamHandler *handler.MatchStreamHandler,
+	lineupHandler *handler.LineupHandler,
+	transferHandler *handler.TransferHandler,
+	disciplineHandler *handler.DisciplineHandler,
+	availabilityHandler *handler.AvailabilityHandler,
+	statsHandler *handler.StatsHandler,
+	ratingHandler *handler.RatingHandler,
+	predictionHandler *handler.PredictionHandler,
+	venueHandler *handler.VenueHandler,
+	officialHandler *handler.OfficialHandler,
 	jwtService security.JWTService,
 ) *Router {
 	return &Router{
-		authHandler:   authHandler,
-		teamHandler:   teamHandler,
-		playerHandler: playerHandler,
-		matchHandler:  matchHandler,
-		reportHandler: reportHandler,
-		jwtService:    jwtService,
+		authHandler:         authHandler,
+		teamHandler:         teamHandler,
+		playerHandler:       playerHandler,
+		matchHandler:        matchHandler,
+		reportHandler:       reportHandler,
+		competitionHandler:  competitionHandler,
+		fixtureHandler:      fixtureHandler,
+		bracketHandler:      bracketHandler,
+		groupHandler:        groupHandler,
+		matchEventHandler:   matchEventHandler,
+		liveMatchHandler:    liveMatchHandler,
+		matchStreamHandler:  matchStreamHandler,
+		lineupHandler:       lineupHandler,
+		transferHandler:     transferHandler,
+		disciplineHandler:   disciplineHandler,
+		availabilityHandler: availabilityHandler,
+		statsHandler:        statsHandler,
+		ratingHandler:       ratingHandler,
+		predictionHandler:   predictionHandler,
+		venueHandler:        venueHandler,
+		officialHandler:     officialHandler,
+		jwtService:          jwtService,
 	}
 }
 
@@ -73,6 +121,8 @@ func (r *Router) Setup(engine *gin.Engine) {
 			// Public routes
 			teams.GET("", r.teamHandler.GetAll)
 			teams.GET("/:id", r.teamHandler.GetByID)
+			teams.GET("/:id/stats", r.statsHandler.GetTeamStats)
+			teams.GET("/:id/rating-history", r.ratingHandler.GetHistory)
 
 			// Protected routes (Admin only)
 			teamsAdmin := teams.Group("")
@@ -85,12 +135,33 @@ func (r *Router) Setup(engine *gin.Engine) {
 			}
 		}
 
+		// Venue routes
+		venues := v1.Group("/venues")
+		{
+			// Public routes
+			venues.GET("", r.venueHandler.GetAll)
+			venues.GET("/:id", r.venueHandler.GetByID)
+
+			// Protected routes (Admin only)
+			venuesAdmin := venues.Group("")
+			venuesAdmin.Use(middleware.AuthMiddleware(r.jwtService))
+			venuesAdmin.Use(middleware.AdminMiddleware())
+			{
+				venuesAdmin.POST("", r.venueHandler.Create)
+				venuesAdmin.PUT("/:id", r.venueHandler.Update)
+				venuesAdmin.DELETE("/:id", r.venueHandler.Delete)
+			}
+		}
+
 		// Player routes
 		players := v1.Group("/players")
 		{
 			// Public routes
 			players.GET("", r.playerHandler.GetAll)
 			players.GET("/:id", r.playerHandler.GetByID)
+			players.GET("/:id/career", r.transferHandler.GetCareer)
+			players.GET("/:id/availability", r.availabilityHandler.GetAll)
+			players.GET("/:id/stats", r.statsHandler.GetPlayerStats)
 
 			// Protected routes (Admin only)
 			playersAdmin := players.Group("")
@@ -100,6 +171,9 @@ func (r *Router) Setup(engine *gin.Engine) {
 				playersAdmin.POST("", r.playerHandler.Create)
 				playersAdmin.PUT("/:id", r.playerHandler.Update)
 				playersAdmin.DELETE("/:id", r.playerHandler.Delete)
+				playersAdmin.POST("/:id/transfers", r.transferHandler.Transfer)
+				playersAdmin.POST("/:id/availability", r.availabilityHandler.Create)
+				playersAdmin.POST("/:id/availability/:availability_id/close", r.availabilityHandler.Close)
 			}
 		}
 
@@ -108,7 +182,13 @@ func (r *Router) Setup(engine *gin.Engine) {
 		{
 			// Public routes
 			matches.GET("", r.matchHandler.GetAll)
+			matches.GET("/stream", r.matchStreamHandler.StreamAll)
 			matches.GET("/:id", r.matchHandler.GetByID)
+			matches.GET("/:id/stream", r.matchStreamHandler.Stream)
+			matches.GET("/:id/events", r.matchEventHandler.GetAll)
+			matches.GET("/:id/lineups", r.lineupHandler.GetAll)
+			matches.GET("/:id/prediction", r.predictionHandler.GetPrediction)
+			matches.GET("/:id/officials", r.officialHandler.GetMatchOfficials)
 
 			// Protected routes (Admin only)
 			matchesAdmin := matches.Group("")
@@ -119,6 +199,98 @@ func (r *Router) Setup(engine *gin.Engine) {
 				matchesAdmin.PUT("/:id", r.matchHandler.Update)
 				matchesAdmin.DELETE("/:id", r.matchHandler.Delete)
 				matchesAdmin.POST("/:id/result", r.matchHandler.RecordResult)
+				matchesAdmin.POST("/:id/events", r.matchEventHandler.Create)
+				matchesAdmin.PUT("/:id/events/:event_id", r.matchEventHandler.Update)
+				matchesAdmin.DELETE("/:id/events/:event_id", r.matchEventHandler.Delete)
+				matchesAdmin.PUT("/:id/lineups/:team_id", r.lineupHandler.Save)
+				matchesAdmin.DELETE("/:id/lineups/:team_id", r.lineupHandler.Delete)
+				matchesAdmin.PUT("/:id/officials", r.officialHandler.AssignOfficials)
+
+				// Live match
+				matchesAdmin.POST("/:id/kickoff", r.liveMatchHandler.Kickoff)
+				matchesAdmin.POST("/:id/half-time", r.liveMatchHandler.HalfTime)
+				matchesAdmin.POST("/:id/second-half", r.liveMatchHandler.SecondHalf)
+				matchesAdmin.POST("/:id/full-time", r.liveMatchHandler.FullTime)
+				matchesAdmin.POST("/:id/extra-time", r.liveMatchHandler.ExtraTime)
+				matchesAdmin.POST("/:id/finish", r.liveMatchHandler.Finish)
+				matchesAdmin.POST("/:id/goals", r.liveMatchHandler.AddGoal)
+				matchesAdmin.DELETE("/:id/goals/:goal_id", r.liveMatchHandler.RemoveGoal)
+			}
+		}
+
+		// Official routes
+		officials := v1.Group("/officials")
+		{
+			// Public routes
+			officials.GET("", r.officialHandler.GetAll)
+			officials.GET("/:id", r.officialHandler.GetByID)
+			officials.GET("/:id/schedule", r.officialHandler.GetSchedule)
+
+			// Protected routes (Admin only)
+			officialsAdmin := officials.Group("")
+			officialsAdmin.Use(middleware.AuthMiddleware(r.jwtService))
+			officialsAdmin.Use(middleware.AdminMiddleware())
+			{
+				officialsAdmin.POST("", r.officialHandler.Create)
+				officialsAdmin.PUT("/:id", r.officialHandler.Update)
+				officialsAdmin.DELETE("/:id", r.officialHandler.Delete)
+				officialsAdmin.POST("/:id/account", r.officialHandler.CreateAccount)
+			}
+
+			// Protected routes (Match officials only)
+			officialsMe := officials.Group("/me")
+			officialsMe.Use(middleware.AuthMiddleware(r.jwtService))
+			officialsMe.Use(middleware.OfficialMiddleware())
+			{
+				officialsMe.GET("/schedule", r.officialHandler.GetMySchedule)
+				officialsMe.POST("/matches/:id/report", r.officialHandler.SubmitReport)
+			}
+		}
+
+		// Competition routes
+		competitions := v1.Group("/competitions")
+		{
+			// Public routes
+			competitions.GET("", r.competitionHandler.GetAll)
+			competitions.GET("/:id", r.competitionHandler.GetByID)
+			competitions.GET("/:id/seasons", r.competitionHandler.GetSeasons)
+			competitions.GET("/:id/seasons/:season_id", r.competitionHandler.GetSeason)
+			competitions.GET("/:id/seasons/:season_id/groups", r.groupHandler.GetAll)
+			competitions.GET("/:id/seasons/:season_id/groups/:group_id", r.groupHandler.GetByID)
+
+			// Protected routes (Admin only)
+			competitionsAdmin := competitions.Group("")
+			competitionsAdmin.Use(middleware.AuthMiddleware(r.jwtService))
+			competitionsAdmin.Use(middleware.AdminMiddleware())
+			{
+				competitionsAdmin.POST("", r.competitionHandler.Create)
+				competitionsAdmin.PUT("/:id", r.competitionHandler.Update)
+				competitionsAdmin.DELETE("/:id", r.competitionHandler.Delete)
+				competitionsAdmin.POST("/:id/seasons", r.competitionHandler.CreateSeason)
+				competitionsAdmin.PUT("/:id/seasons/:season_id", r.competitionHandler.UpdateSeason)
+				competitionsAdmin.DELETE("/:id/seasons/:season_id", r.competitionHandler.DeleteSeason)
+				competitionsAdmin.POST("/:id/seasons/:season_id/fixtures", r.fixtureHandler.GenerateRoundRobin)
+				competitionsAdmin.POST("/:id/seasons/:season_id/groups", r.groupHandler.Create)
+				competitionsAdmin.DELETE("/:id/seasons/:season_id/groups/:group_id", r.groupHandler.Delete)
+				competitionsAdmin.POST("/:id/seasons/:season_id/groups/fixtures", r.groupHandler.GenerateFixtures)
+				competitionsAdmin.POST("/:id/seasons/:season_id/knockout", r.groupHandler.GenerateKnockout)
+			}
+		}
+
+		// Bracket routes
+		brackets := v1.Group("/brackets")
+		{
+			// Public routes
+			brackets.GET("", r.bracketHandler.GetAll)
+			brackets.GET("/:id", r.bracketHandler.GetByID)
+
+			// Protected routes (Admin only)
+			bracketsAdmin := brackets.Group("")
+			bracketsAdmin.Use(middleware.AuthMiddleware(r.jwtService))
+			bracketsAdmin.Use(middleware.AdminMiddleware())
+			{
+				bracketsAdmin.POST("", r.bracketHandler.Create)
+				bracketsAdmin.DELETE("/:id", r.bracketHandler.Delete)
 			}
 		}
 
@@ -128,6 +300,10 @@ func (r *Router) Setup(engine *gin.Engine) {
 			reports.GET("/matches", r.reportHandler.GetAllMatchReports)
 			reports.GET("/matches/:id", r.reportHandler.GetMatchReport)
 			reports.GET("/top-scorers", r.reportHandler.GetTopScorers)
+			reports.GET("/standings", r.reportHandler.GetStandings)
+			reports.GET("/head-to-head", r.reportHandler.GetHeadToHead)
+			reports.GET("/ratings", r.ratingHandler.GetRatings)
+			reports.GET("/discipline", r.disciplineHandler.GetReport)
 		}
 	}
 }
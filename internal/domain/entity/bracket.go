@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bracket represents a knockout (cup) tournament tree
+type Bracket struct {
+	BaseEntity
+	SeasonID          *uuid.UUID   `gorm:"type:uuid;index" json:"season_id"`
+	Name              string       `gorm:"not null;size:255" json:"name"`
+	Rounds            int          `gorm:"not null" json:"rounds"`
+	TwoLegged         bool         `gorm:"default:false" json:"two_legged"`
+	TwoLeggedFinal    bool         `gorm:"default:false" json:"two_legged_final"`
+	StartDate         time.Time    `gorm:"not null" json:"start_date"`
+	KickoffTime       string       `gorm:"not null;size:10" json:"kickoff_time"` // Format: HH:MM
+	RoundIntervalDays int          `gorm:"not null;default:7" json:"round_interval_days"`
+	LegIntervalDays   int          `gorm:"not null;default:7" json:"leg_interval_days"`
+	Ties              []BracketTie `gorm:"foreignKey:BracketID" json:"ties,omitempty"`
+}
+
+// TableName returns the table name for Bracket entity
+func (Bracket) TableName() string {
+	return "brackets"
+}
+
+// IsTwoLeggedRound reports whether ties in the given round are played over two legs
+func (b *Bracket) IsTwoLeggedRound(round int) bool {
+	if round == b.Rounds {
+		return b.TwoLeggedFinal
+	}
+	return b.TwoLegged
+}
+
+// RoundDate returns the date of the first leg of the given round
+func (b *Bracket) RoundDate(round int) time.Time {
+	return b.StartDate.AddDate(0, 0, (round-1)*b.RoundIntervalDays)
+}
+
+// BracketTie represents a single pairing in a bracket round.
+// The home team hosts the first leg; in two-legged ties the away team
+// hosts the second leg.
+type BracketTie struct {
+	BaseEntity
+	BracketID        uuid.UUID  `gorm:"type:uuid;not null;index" json:"bracket_id"`
+	Round            int        `gorm:"not null" json:"round"` // 1 is the first round
+	Slot             int        `gorm:"not null" json:"slot"`  // Position within the round, starting at 0
+	HomeSeed         *int       `json:"home_seed"`
+	AwaySeed         *int       `json:"away_seed"`
+	HomeTeamID       *uuid.UUID `gorm:"type:uuid;index" json:"home_team_id"`
+	AwayTeamID       *uuid.UUID `gorm:"type:uuid;index" json:"away_team_id"`
+	TwoLegged        bool       `gorm:"default:false" json:"two_legged"`
+	IsBye            bool       `gorm:"default:false" json:"is_bye"`
+	FirstLegMatchID  *uuid.UUID `gorm:"type:uuid;index" json:"first_leg_match_id"`
+	SecondLegMatchID *uuid.UUID `gorm:"type:uuid;index" json:"second_leg_match_id"`
+	WinnerTeamID     *uuid.UUID `gorm:"type:uuid" json:"winner_team_id"`
+	HomeTeam         *Team      `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
+	AwayTeam         *Team      `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
+	FirstLeg         *Match     `gorm:"foreignKey:FirstLegMatchID" json:"first_leg,omitempty"`
+	SecondLeg        *Match     `gorm:"foreignKey:SecondLegMatchID" json:"second_leg,omitempty"`
+}
+
+// TableName returns the table name for BracketTie entity
+func (BracketTie) TableName() string {
+	return "bracket_ties"
+}
+
+// Aggregate returns the combined score of both legs from the perspective of
+// the tie's home and away teams. It reports false until every leg is completed.
+func (t *BracketTie) Aggregate() (home int, away int, ok bool) {
+	if t.FirstLeg == nil || t.FirstLeg.Status != MatchStatusCompleted ||
+		t.FirstLeg.HomeScore == nil || t.FirstLeg.AwayScore == nil {
+		return 0, 0, false
+	}
+	home, away = *t.FirstLeg.HomeScore, *t.FirstLeg.AwayScore
+
+	if t.TwoLegged {
+		if t.SecondLeg == nil || t.SecondLeg.Status != MatchStatusCompleted ||
+			t.SecondLeg.HomeScore == nil || t.SecondLeg.AwayScore == nil {
+			return 0, 0, false
+		}
+		// The away team hosts the second leg
+		home += *t.SecondLeg.AwayScore
+		away += *t.SecondLeg.HomeScore
+	}
+
+	return home, away, true
+}
+
+// DecidingLeg returns the match whose penalty shootout settles a level tie
+func (t *BracketTie) DecidingLeg() *Match {
+	if t.TwoLegged {
+		return t.SecondLeg
+	}
+	return t.FirstLeg
+}
+
+// DetermineWinner returns the team that progresses from the tie, or nil
+// while the tie is still undecided.
+func (t *BracketTie) DetermineWinner() *uuid.UUID {
+	if t.IsBye {
+		return t.HomeTeamID
+	}
+
+	home, away, ok := t.Aggregate()
+	if !ok {
+		return nil
+	}
+	if home > away {
+		return t.HomeTeamID
+	}
+	if away > home {
+		return t.AwayTeamID
+	}
+
+	// Level on aggregate: the shootout after the deciding leg settles it
+	leg := t.DecidingLeg()
+	switch leg.GetPenaltyResult() {
+	case ResultHomeWin:
+		if leg.HomeTeamID == derefUUID(t.HomeTeamID) {
+			return t.HomeTeamID
+		}
+		return t.AwayTeamID
+	case ResultAwayWin:
+		if leg.AwayTeamID == derefUUID(t.HomeTeamID) {
+			return t.HomeTeamID
+		}
+		return t.AwayTeamID
+	default:
+		return nil
+	}
+}
+
+// derefUUID returns the value of id, or uuid.Nil when id is nil
+func derefUUID(id *uuid.UUID) uuid.UUID {
+	if id == nil {
+		return uuid.Nil
+	}
+	return *id
+}
@@ -0,0 +1,28 @@
+package entity
+
+// CompetitionType represents the format of a competition
+type CompetitionType string
+
+const (
+	CompetitionTypeLeague CompetitionType = "league"
+	CompetitionTypeCup    CompetitionType = "cup"
+)
+
+// Competition represents a tournament such as a league or a cup
+type Competition struct {
+	BaseEntity
+	Name        string          `gorm:"not null;size:255" json:"name"`
+	Type        CompetitionType `gorm:"type:varchar(20);default:'league'" json:"type"`
+	Description string          `gorm:"size:1000" json:"description"`
+	Seasons     []Season        `gorm:"foreignKey:CompetitionID" json:"seasons,omitempty"`
+}
+
+// TableName returns the table name for Competition entity
+func (Competition) TableName() string {
+	return "competitions"
+}
+
+// IsValidCompetitionType checks if a competition type is valid
+func IsValidCompetitionType(competitionType CompetitionType) bool {
+	return competitionType == CompetitionTypeLeague || competitionType == CompetitionTypeCup
+}
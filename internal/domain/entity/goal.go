@@ -5,17 +5,31 @@ import "github.com/google/uuid"
 // Goal represents a goal scored in a match
 type Goal struct {
 	BaseEntity
-	MatchID   uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
-	PlayerID  uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
-	TeamID    uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
-	Minute    int       `gorm:"not null" json:"minute"` // Minute when goal was scored
-	IsOwnGoal bool      `gorm:"default:false" json:"is_own_goal"`
-	Match     *Match    `gorm:"foreignKey:MatchID" json:"match,omitempty"`
-	Player    *Player   `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
-	Team      *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	MatchID      uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	PlayerID     uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
+	TeamID       uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
+	Minute       int       `gorm:"not null" json:"minute"`                  // Minute when goal was scored
+	StoppageTime int       `gorm:"not null;default:0" json:"stoppage_time"` // Added minutes, e.g. 2 in 45+2
+	IsOwnGoal    bool      `gorm:"default:false" json:"is_own_goal"`
+	IsPenalty    bool      `gorm:"default:false" json:"is_penalty"`
+	Match        *Match    `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+	Player       *Player   `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+	Team         *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
 }
 
 // TableName returns the table name for Goal entity
 func (Goal) TableName() string {
 	return "goals"
 }
+
+// ScoringTeamID returns the team the goal counts for. TeamID is the scorer's
+// own team, so an own goal counts for the opponent.
+func (g *Goal) ScoringTeamID(homeTeamID, awayTeamID uuid.UUID) uuid.UUID {
+	if !g.IsOwnGoal {
+		return g.TeamID
+	}
+	if g.TeamID == homeTeamID {
+		return awayTeamID
+	}
+	return homeTeamID
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+)
+
+// Group represents a group in the group stage of a season
+type Group struct {
+	BaseEntity
+	SeasonID uuid.UUID   `gorm:"type:uuid;not null;index" json:"season_id"`
+	Name     string      `gorm:"not null;size:50" json:"name"`
+	Teams    []GroupTeam `gorm:"foreignKey:GroupID" json:"teams,omitempty"`
+}
+
+// TableName returns the table name for Group entity
+func (Group) TableName() string {
+	return "season_groups"
+}
+
+// GroupTeam represents a team's membership of a group
+type GroupTeam struct {
+	BaseEntity
+	GroupID uuid.UUID `gorm:"type:uuid;not null;index" json:"group_id"`
+	TeamID  uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
+	Team    *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+}
+
+// TableName returns the table name for GroupTeam entity
+func (GroupTeam) TableName() string {
+	return "group_teams"
+}
+
+// TeamIDs returns the IDs of the teams in the group
+func (g *Group) TeamIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(g.Teams))
+	for i, member := range g.Teams {
+		ids[i] = member.TeamID
+	}
+	return ids
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+)
+
+// StartingPlayers is the number of players a team starts a match with
+const StartingPlayers = 11
+
+// MaxBenchPlayers is the largest number of substitutes a team may name
+const MaxBenchPlayers = 12
+
+// Lineup represents the squad a team selects for a match
+type Lineup struct {
+	BaseEntity
+	MatchID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"match_id"`
+	TeamID       uuid.UUID      `gorm:"type:uuid;not null;index" json:"team_id"`
+	Formation    string         `gorm:"size:20" json:"formation"`
+	CaptainID    uuid.UUID      `gorm:"type:uuid;not null" json:"captain_id"`
+	GoalkeeperID uuid.UUID      `gorm:"type:uuid;not null" json:"goalkeeper_id"`
+	Team         *Team          `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	Players      []LineupPlayer `gorm:"foreignKey:LineupID" json:"players,omitempty"`
+}
+
+// TableName returns the table name for Lineup entity
+func (Lineup) TableName() string {
+	return "lineups"
+}
+
+// LineupPlayer represents a player named in a lineup, either starting or on
+// the bench
+type LineupPlayer struct {
+	BaseEntity
+	LineupID     uuid.UUID `gorm:"type:uuid;not null;index" json:"lineup_id"`
+	PlayerID     uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
+	JerseyNumber int       `gorm:"not null" json:"jersey_number"`
+	IsStarter    bool      `gorm:"default:false" json:"is_starter"`
+	Player       *Player   `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+}
+
+// TableName returns the table name for LineupPlayer entity
+func (LineupPlayer) TableName() string {
+	return "lineup_players"
+}
+
+// Includes checks if the player is named in the lineup
+func (l *Lineup) Includes(playerID uuid.UUID) bool {
+	return l.find(playerID) != nil
+}
+
+// IsStarting checks if the player is in the starting XI
+func (l *Lineup) IsStarting(playerID uuid.UUID) bool {
+	player := l.find(playerID)
+	return player != nil && player.IsStarter
+}
+
+func (l *Lineup) find(playerID uuid.UUID) *LineupPlayer {
+	for i := range l.Players {
+		if l.Players[i].PlayerID == playerID {
+			return &l.Players[i]
+		}
+	}
+	return nil
+}
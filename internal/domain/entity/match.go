@@ -16,19 +16,41 @@ const (
 	MatchStatusCancelled MatchStatus = "cancelled"
 )
 
+// MatchPeriod represents the phase of play of a live match
+type MatchPeriod string
+
+const (
+	PeriodFirstHalf  MatchPeriod = "first_half"
+	PeriodHalfTime   MatchPeriod = "half_time"
+	PeriodSecondHalf MatchPeriod = "second_half"
+	PeriodExtraTime  MatchPeriod = "extra_time"
+	PeriodFullTime   MatchPeriod = "full_time"
+)
+
 // Match represents a football match between two teams
 type Match struct {
 	BaseEntity
-	MatchDate    time.Time   `gorm:"not null;index" json:"match_date"`
-	MatchTime    string      `gorm:"not null;size:10" json:"match_time"` // Format: HH:MM
-	HomeTeamID   uuid.UUID   `gorm:"type:uuid;not null;index" json:"home_team_id"`
-	AwayTeamID   uuid.UUID   `gorm:"type:uuid;not null;index" json:"away_team_id"`
-	HomeScore    *int        `gorm:"default:null" json:"home_score"`
-	AwayScore    *int        `gorm:"default:null" json:"away_score"`
-	Status       MatchStatus `gorm:"type:varchar(20);default:'scheduled'" json:"status"`
-	HomeTeam     *Team       `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
-	AwayTeam     *Team       `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
-	Goals        []Goal      `gorm:"foreignKey:MatchID" json:"goals,omitempty"`
+	MatchDate       time.Time    `gorm:"not null;index" json:"match_date"`
+	MatchTime       string       `gorm:"not null;size:10" json:"match_time"` // Format: HH:MM
+	HomeTeamID      uuid.UUID    `gorm:"type:uuid;not null;index" json:"home_team_id"`
+	AwayTeamID      uuid.UUID    `gorm:"type:uuid;not null;index" json:"away_team_id"`
+	SeasonID        *uuid.UUID   `gorm:"type:uuid;index" json:"season_id"`
+	GroupID         *uuid.UUID   `gorm:"type:uuid;index" json:"group_id"`
+	VenueID         *uuid.UUID   `gorm:"type:uuid;index" json:"venue_id"`
+	HomeScore       *int         `gorm:"default:null" json:"home_score"`
+	AwayScore       *int         `gorm:"default:null" json:"away_score"`
+	ExtraTime       bool         `gorm:"default:false" json:"extra_time"` // Scores include extra time
+	HomePenalties   *int         `gorm:"default:null" json:"home_penalties"`
+	AwayPenalties   *int         `gorm:"default:null" json:"away_penalties"`
+	Status          MatchStatus  `gorm:"type:varchar(20);default:'scheduled'" json:"status"`
+	Period          MatchPeriod  `gorm:"type:varchar(20)" json:"period"` // Empty until kickoff
+	PeriodStartedAt *time.Time   `json:"period_started_at"`              // When the running period kicked off
+	HomeTeam        *Team        `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
+	AwayTeam        *Team        `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
+	Season          *Season      `gorm:"foreignKey:SeasonID" json:"season,omitempty"`
+	Venue           *Venue       `gorm:"foreignKey:VenueID" json:"venue,omitempty"`
+	Goals           []Goal       `gorm:"foreignKey:MatchID" json:"goals,omitempty"`
+	Events          []MatchEvent `gorm:"foreignKey:MatchID" json:"events,omitempty"`
 }
 
 // TableName returns the table name for Match entity
@@ -45,6 +67,56 @@ const (
 	ResultDraw    MatchResult = "draw"
 )
 
+// Clock returns the running match minute and its stoppage time at the given
+// moment, e.g. 45 and 2 for 45+2. It reports false while the clock is stopped.
+func (m *Match) Clock(now time.Time) (int, int, bool) {
+	var start, length int
+	switch m.Period {
+	case PeriodFirstHalf:
+		start, length = 0, 45
+	case PeriodSecondHalf:
+		start, length = 45, 45
+	case PeriodExtraTime:
+		start, length = 90, 30
+	default:
+		return 0, 0, false
+	}
+	if m.PeriodStartedAt == nil {
+		return 0, 0, false
+	}
+
+	elapsed := int(now.Sub(*m.PeriodStartedAt).Minutes())
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	// Minutes past the end of the period count as stoppage time
+	minute := start + elapsed + 1
+	if end := start + length; minute > end {
+		return end, minute - end, true
+	}
+	return minute, 0, true
+}
+
+// KicksOffBefore checks if the match is scheduled before the other match
+func (m *Match) KicksOffBefore(other *Match) bool {
+	if !m.MatchDate.Equal(other.MatchDate) {
+		return m.MatchDate.Before(other.MatchDate)
+	}
+	return m.MatchTime < other.MatchTime
+}
+
+// KickoffAt returns the date and time the match kicks off, or false when the
+// match time is not in HH:MM format
+func (m *Match) KickoffAt() (time.Time, bool) {
+	kickoff, err := time.Parse("15:04", m.MatchTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+	year, month, day := m.MatchDate.Date()
+	return time.Date(year, month, day, kickoff.Hour(), kickoff.Minute(), 0, 0, m.MatchDate.Location()), true
+}
+
 // GetResult returns the result of the match
 func (m *Match) GetResult() MatchResult {
 	if m.HomeScore == nil || m.AwayScore == nil {
@@ -59,6 +131,21 @@ func (m *Match) GetResult() MatchResult {
 	return ResultDraw
 }
 
+// GetPenaltyResult returns the result of the penalty shootout, or an empty
+// result when no shootout was held
+func (m *Match) GetPenaltyResult() MatchResult {
+	if m.HomePenalties == nil || m.AwayPenalties == nil {
+		return ""
+	}
+	if *m.HomePenalties > *m.AwayPenalties {
+		return ResultHomeWin
+	}
+	if *m.AwayPenalties > *m.HomePenalties {
+		return ResultAwayWin
+	}
+	return ResultDraw
+}
+
 // GetResultDisplay returns a human-readable result string
 func (m *Match) GetResultDisplay() string {
 	result := m.GetResult()
@@ -68,6 +155,12 @@ func (m *Match) GetResultDisplay() string {
 	case ResultAwayWin:
 		return "Away Team Win"
 	case ResultDraw:
+		switch m.GetPenaltyResult() {
+		case ResultHomeWin:
+			return "Home Team Win on Penalties"
+		case ResultAwayWin:
+			return "Away Team Win on Penalties"
+		}
 		return "Draw"
 	default:
 		return "Not Played"
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// MatchEventType represents the kind of match event
+type MatchEventType string
+
+const (
+	MatchEventYellowCard    MatchEventType = "yellow_card"
+	MatchEventSecondYellow  MatchEventType = "second_yellow"
+	MatchEventRedCard       MatchEventType = "red_card"
+	MatchEventSubstitution  MatchEventType = "substitution"
+	MatchEventAssist        MatchEventType = "assist"
+	MatchEventPenaltyMissed MatchEventType = "penalty_missed"
+)
+
+// MatchEvent represents anything other than a goal that happens in a match.
+// For a substitution PlayerID is the player going off and PlayerInID the
+// player coming on; an assist is linked to the goal it set up.
+type MatchEvent struct {
+	BaseEntity
+	MatchID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"match_id"`
+	TeamID       uuid.UUID      `gorm:"type:uuid;not null;index" json:"team_id"`
+	PlayerID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"player_id"`
+	Type         MatchEventType `gorm:"type:varchar(20);not null;index" json:"type"`
+	Minute       int            `gorm:"not null" json:"minute"`
+	StoppageTime int            `gorm:"not null;default:0" json:"stoppage_time"` // Added minutes, e.g. 2 in 45+2
+	PlayerInID   *uuid.UUID     `gorm:"type:uuid;index" json:"player_in_id"`
+	GoalID       *uuid.UUID     `gorm:"type:uuid;index" json:"goal_id"`
+	Team         *Team          `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	Player       *Player        `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+	PlayerIn     *Player        `gorm:"foreignKey:PlayerInID" json:"player_in,omitempty"`
+}
+
+// TableName returns the table name for MatchEvent entity
+func (MatchEvent) TableName() string {
+	return "match_events"
+}
+
+// IsValidMatchEventType checks if the event type is valid
+func IsValidMatchEventType(eventType MatchEventType) bool {
+	switch eventType {
+	case MatchEventYellowCard, MatchEventSecondYellow, MatchEventRedCard,
+		MatchEventSubstitution, MatchEventAssist, MatchEventPenaltyMissed:
+		return true
+	}
+	return false
+}
+
+// IsCard reports whether the event is a yellow or red card
+func (e *MatchEvent) IsCard() bool {
+	return e.Type == MatchEventYellowCard || e.IsSendingOff()
+}
+
+// IsSendingOff reports whether the event sends the player off
+func (e *MatchEvent) IsSendingOff() bool {
+	return e.Type == MatchEventSecondYellow || e.Type == MatchEventRedCard
+}
+
+// MatchClock formats a minute with its stoppage time, e.g. 45+2
+func MatchClock(minute, stoppageTime int) string {
+	if stoppageTime > 0 {
+		return fmt.Sprintf("%d+%d", minute, stoppageTime)
+	}
+	return fmt.Sprintf("%d", minute)
+}
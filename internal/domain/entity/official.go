@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OfficialGrade represents the level an official is certified to officiate at
+type OfficialGrade string
+
+const (
+	OfficialGradeInternational OfficialGrade = "international"
+	OfficialGradeNational      OfficialGrade = "national"
+	OfficialGradeRegional      OfficialGrade = "regional"
+	OfficialGradeLocal         OfficialGrade = "local"
+)
+
+// OfficialRole represents the position an official holds in a match
+type OfficialRole string
+
+const (
+	OfficialRoleReferee        OfficialRole = "referee"
+	OfficialRoleAssistant1     OfficialRole = "assistant_1"
+	OfficialRoleAssistant2     OfficialRole = "assistant_2"
+	OfficialRoleFourthOfficial OfficialRole = "fourth_official"
+)
+
+// Official represents a referee or other match official
+type Official struct {
+	BaseEntity
+	Name                string        `gorm:"not null;size:255" json:"name"`
+	Grade               OfficialGrade `gorm:"type:varchar(20);not null" json:"grade"`
+	CertificationExpiry time.Time     `gorm:"not null" json:"certification_expiry"` // Last day the official may officiate
+	UserID              *uuid.UUID    `gorm:"type:uuid;uniqueIndex" json:"user_id"` // Account the official logs in with
+	User                *User         `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// TableName returns the table name for Official entity
+func (Official) TableName() string {
+	return "officials"
+}
+
+// IsValidOfficialGrade checks if an official grade is valid
+func IsValidOfficialGrade(grade OfficialGrade) bool {
+	switch grade {
+	case OfficialGradeInternational, OfficialGradeNational, OfficialGradeRegional, OfficialGradeLocal:
+		return true
+	}
+	return false
+}
+
+// IsCertifiedOn checks if the official's certification covers the date
+func (o *Official) IsCertifiedOn(date time.Time) bool {
+	return !date.After(o.CertificationExpiry)
+}
+
+// MatchOfficial represents an official assigned to a match
+type MatchOfficial struct {
+	BaseEntity
+	MatchID    uuid.UUID    `gorm:"type:uuid;not null;index" json:"match_id"`
+	OfficialID uuid.UUID    `gorm:"type:uuid;not null;index" json:"official_id"`
+	Role       OfficialRole `gorm:"type:varchar(20);not null" json:"role"`
+	ReportedAt *time.Time   `json:"reported_at"` // When the official submitted the match report
+	Match      *Match       `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+	Official   *Official    `gorm:"foreignKey:OfficialID" json:"official,omitempty"`
+}
+
+// TableName returns the table name for MatchOfficial entity
+func (MatchOfficial) TableName() string {
+	return "match_officials"
+}
+
+// IsValidOfficialRole checks if an official role is valid
+func IsValidOfficialRole(role OfficialRole) bool {
+	switch role {
+	case OfficialRoleReferee, OfficialRoleAssistant1, OfficialRoleAssistant2, OfficialRoleFourthOfficial:
+		return true
+	}
+	return false
+}
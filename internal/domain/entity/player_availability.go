@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AvailabilityStatus represents why a player cannot be selected
+type AvailabilityStatus string
+
+const (
+	AvailabilityInjured     AvailabilityStatus = "injured"
+	AvailabilityIll         AvailabilityStatus = "ill"
+	AvailabilityUnavailable AvailabilityStatus = "unavailable" // Personal reasons, international duty, etc.
+)
+
+// PlayerAvailability represents a period in which a player is not available.
+// A record is open until the player returns, when EndDate is set; an open
+// record ends on its expected return date, if any.
+type PlayerAvailability struct {
+	BaseEntity
+	PlayerID       uuid.UUID          `gorm:"type:uuid;not null;index" json:"player_id"`
+	Status         AvailabilityStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Reason         string             `gorm:"size:500" json:"reason"`
+	StartDate      time.Time          `gorm:"not null;index" json:"start_date"`
+	ExpectedReturn *time.Time         `json:"expected_return"`
+	EndDate        *time.Time         `gorm:"index" json:"end_date"` // Date the player returned
+	Player         *Player            `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+}
+
+// TableName returns the table name for PlayerAvailability entity
+func (PlayerAvailability) TableName() string {
+	return "player_availabilities"
+}
+
+// IsValidAvailabilityStatus checks if an availability status is valid
+func IsValidAvailabilityStatus(status AvailabilityStatus) bool {
+	switch status {
+	case AvailabilityInjured, AvailabilityIll, AvailabilityUnavailable:
+		return true
+	}
+	return false
+}
+
+// IsClosed checks if the player has returned
+func (a *PlayerAvailability) IsClosed() bool {
+	return a.EndDate != nil
+}
+
+// IsActiveOn checks if the player is unavailable on the date
+func (a *PlayerAvailability) IsActiveOn(date time.Time) bool {
+	if date.Before(a.StartDate) {
+		return false
+	}
+	until := a.ExpectedReturn
+	if a.IsClosed() {
+		until = a.EndDate
+	}
+	return until == nil || date.Before(*until)
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Season represents a single edition of a competition
+type Season struct {
+	BaseEntity
+	CompetitionID uuid.UUID    `gorm:"type:uuid;not null;index" json:"competition_id"`
+	Name          string       `gorm:"not null;size:100" json:"name"` // e.g. 2025/2026
+	StartDate     time.Time    `gorm:"not null" json:"start_date"`
+	EndDate       time.Time    `gorm:"not null" json:"end_date"`
+	Competition   *Competition `gorm:"foreignKey:CompetitionID" json:"competition,omitempty"`
+}
+
+// TableName returns the table name for Season entity
+func (Season) TableName() string {
+	return "seasons"
+}
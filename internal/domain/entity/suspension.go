@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+)
+
+// SuspensionReason represents why a player was suspended
+type SuspensionReason string
+
+const (
+	SuspensionYellowAccumulation SuspensionReason = "yellow_accumulation"
+	SuspensionSecondYellow       SuspensionReason = "second_yellow"
+	SuspensionRedCard            SuspensionReason = "red_card"
+)
+
+// Suspension represents a ban a player incurred through a card. It is served
+// as the team the player was carded for completes the matches that follow.
+type Suspension struct {
+	BaseEntity
+	PlayerID uuid.UUID        `gorm:"type:uuid;not null;index" json:"player_id"`
+	TeamID   uuid.UUID        `gorm:"type:uuid;not null;index" json:"team_id"`
+	MatchID  uuid.UUID        `gorm:"type:uuid;not null;index" json:"match_id"` // Match the card was received in
+	EventID  uuid.UUID        `gorm:"type:uuid;not null" json:"event_id"`       // Card that triggered the ban
+	Reason   SuspensionReason `gorm:"type:varchar(30);not null" json:"reason"`
+	Matches  int              `gorm:"not null" json:"matches"`
+	Player   *Player          `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+	Team     *Team            `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	Match    *Match           `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+}
+
+// TableName returns the table name for Suspension entity
+func (Suspension) TableName() string {
+	return "suspensions"
+}
@@ -1,14 +1,20 @@
 package entity
 
+import (
+	"github.com/google/uuid"
+)
+
 // Team represents a football team
 type Team struct {
 	BaseEntity
-	Name        string   `gorm:"not null;size:255" json:"name"`
-	Logo        string   `gorm:"size:500" json:"logo"`
-	FoundedYear int      `gorm:"not null" json:"founded_year"`
-	Address     string   `gorm:"size:500" json:"address"`
-	City        string   `gorm:"not null;size:100" json:"city"`
-	Players     []Player `gorm:"foreignKey:TeamID" json:"players,omitempty"`
+	Name        string     `gorm:"not null;size:255" json:"name"`
+	Logo        string     `gorm:"size:500" json:"logo"`
+	FoundedYear int        `gorm:"not null" json:"founded_year"`
+	Address     string     `gorm:"size:500" json:"address"`
+	City        string     `gorm:"not null;size:100" json:"city"`
+	HomeVenueID *uuid.UUID `gorm:"type:uuid;index" json:"home_venue_id"` // Home ground, the default venue of home matches
+	HomeVenue   *Venue     `gorm:"foreignKey:HomeVenueID" json:"home_venue,omitempty"`
+	Players     []Player   `gorm:"foreignKey:TeamID" json:"players,omitempty"`
 }
 
 // TableName returns the table name for Team entity
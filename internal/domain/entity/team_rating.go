@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+)
+
+// TeamRating represents a team's current Elo rating, the result of replaying
+// every completed match it played in order
+type TeamRating struct {
+	BaseEntity
+	TeamID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"team_id"`
+	Rating  float64   `gorm:"not null" json:"rating"`
+	Matches int       `gorm:"not null;default:0" json:"matches"` // Rated matches played
+	Team    *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+}
+
+// TableName returns the table name for TeamRating entity
+func (TeamRating) TableName() string {
+	return "team_ratings"
+}
+
+// RatingHistory represents the change to one team's rating from a match
+type RatingHistory struct {
+	BaseEntity
+	TeamID       uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
+	MatchID      uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	OpponentID   uuid.UUID `gorm:"type:uuid;not null" json:"opponent_id"`
+	RatingBefore float64   `gorm:"not null" json:"rating_before"`
+	RatingAfter  float64   `gorm:"not null" json:"rating_after"`
+	Team         *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	Match        *Match    `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+	Opponent     *Team     `gorm:"foreignKey:OpponentID" json:"opponent,omitempty"`
+}
+
+// TableName returns the table name for RatingHistory entity
+func (RatingHistory) TableName() string {
+	return "rating_histories"
+}
+
+// Change returns how much the match moved the rating
+func (h *RatingHistory) Change() float64 {
+	return h.RatingAfter - h.RatingBefore
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Transfer represents a player's move from one team to another. A player's
+// transfers in date order describe the teams they played for over time.
+type Transfer struct {
+	BaseEntity
+	PlayerID     uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
+	FromTeamID   uuid.UUID `gorm:"type:uuid;not null;index" json:"from_team_id"`
+	ToTeamID     uuid.UUID `gorm:"type:uuid;not null;index" json:"to_team_id"`
+	TransferDate time.Time `gorm:"not null;index" json:"transfer_date"`
+	Fee          float64   `gorm:"default:0" json:"fee"`
+	IsLoan       bool      `gorm:"default:false" json:"is_loan"`
+	Player       *Player   `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+	FromTeam     *Team     `gorm:"foreignKey:FromTeamID" json:"from_team,omitempty"`
+	ToTeam       *Team     `gorm:"foreignKey:ToTeamID" json:"to_team,omitempty"`
+}
+
+// TableName returns the table name for Transfer entity
+func (Transfer) TableName() string {
+	return "transfers"
+}
@@ -4,8 +4,9 @@ package entity
 type UserRole string
 
 const (
-	RoleAdmin UserRole = "admin"
-	RoleUser  UserRole = "user"
+	RoleAdmin    UserRole = "admin"
+	RoleUser     UserRole = "user"
+	RoleOfficial UserRole = "official" // Match official, see Official
 )
 
 // User represents a system user
@@ -26,3 +27,8 @@ func (User) TableName() string {
 func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
 }
+
+// IsOfficial checks if user has match official role
+func (u *User) IsOfficial() bool {
+	return u.Role == RoleOfficial
+}
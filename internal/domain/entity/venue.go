@@ -0,0 +1,34 @@
+package entity
+
+// VenueSurface represents the playing surface of a venue
+type VenueSurface string
+
+const (
+	VenueSurfaceGrass      VenueSurface = "grass"
+	VenueSurfaceArtificial VenueSurface = "artificial"
+	VenueSurfaceHybrid     VenueSurface = "hybrid"
+)
+
+// Venue represents a ground matches are played at
+type Venue struct {
+	BaseEntity
+	Name     string       `gorm:"not null;size:255" json:"name"`
+	Address  string       `gorm:"size:500" json:"address"`
+	City     string       `gorm:"not null;size:100" json:"city"`
+	Capacity int          `gorm:"default:0" json:"capacity"`
+	Surface  VenueSurface `gorm:"type:varchar(20);default:'grass'" json:"surface"`
+}
+
+// TableName returns the table name for Venue entity
+func (Venue) TableName() string {
+	return "venues"
+}
+
+// IsValidVenueSurface checks if a venue surface is valid
+func IsValidVenueSurface(surface VenueSurface) bool {
+	switch surface {
+	case VenueSurfaceGrass, VenueSurfaceArtificial, VenueSurfaceHybrid:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// BracketRepository defines the interface for bracket and tie data operations
+type BracketRepository interface {
+	Create(ctx context.Context, bracket *entity.Bracket) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Bracket, error)
+	FindByIDWithTies(ctx context.Context, id uuid.UUID) (*entity.Bracket, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context, page, limit int) ([]entity.Bracket, int64, error)
+	ExistsBySeasonID(ctx context.Context, seasonID uuid.UUID) (bool, error)
+	FindTieByMatchID(ctx context.Context, matchID uuid.UUID) (*entity.BracketTie, error)
+	FindTie(ctx context.Context, bracketID uuid.UUID, round, slot int) (*entity.BracketTie, error)
+	UpdateTie(ctx context.Context, tie *entity.BracketTie) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// CompetitionRepository defines the interface for competition data operations
+type CompetitionRepository interface {
+	Create(ctx context.Context, competition *entity.Competition) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Competition, error)
+	FindByIDWithSeasons(ctx context.Context, id uuid.UUID) (*entity.Competition, error)
+	Update(ctx context.Context, competition *entity.Competition) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context, page, limit int) ([]entity.Competition, int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -15,9 +16,11 @@ type GoalRepository interface {
 	Update(ctx context.Context, goal *entity.Goal) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Goal, error)
-	FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Goal, error)
+	// FindByPlayerID returns the player's goals in matches played between
+	// from and to, inclusive; a nil bound leaves that end open
+	FindByPlayerID(ctx context.Context, playerID uuid.UUID, from, to *time.Time) ([]entity.Goal, error)
 	DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error
-	GetTopScorers(ctx context.Context, limit int) ([]TopScorerResult, error)
+	GetTopScorers(ctx context.Context, seasonID *uuid.UUID, limit int) ([]TopScorerResult, error)
 }
 
 // TopScorerResult represents a player with their goal statistics
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// GroupRepository defines the interface for group stage data operations
+type GroupRepository interface {
+	Create(ctx context.Context, group *entity.Group) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Group, error)
+	FindBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]entity.Group, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// LineupRepository defines the interface for match lineup data operations
+type LineupRepository interface {
+	Create(ctx context.Context, lineup *entity.Lineup) error
+	FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Lineup, error)
+	FindByMatchAndTeam(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
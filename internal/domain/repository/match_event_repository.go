@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// MatchEventRepository defines the interface for match event data operations
+type MatchEventRepository interface {
+	Create(ctx context.Context, event *entity.MatchEvent) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.MatchEvent, error)
+	Update(ctx context.Context, event *entity.MatchEvent) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchEvent, error)
+	FindByMatchAndPlayer(ctx context.Context, matchID, playerID uuid.UUID) ([]entity.MatchEvent, error)
+	FindAssistByGoalID(ctx context.Context, goalID uuid.UUID) (*entity.MatchEvent, error)
+	DeleteOrphanedAssists(ctx context.Context, matchID uuid.UUID) error
+	FindCards(ctx context.Context, teamID *uuid.UUID) ([]entity.MatchEvent, error)
+	FindCardsByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.MatchEvent, error)
+}
@@ -11,6 +11,7 @@ import (
 // MatchRepository defines the interface for match data operations
 type MatchRepository interface {
 	Create(ctx context.Context, match *entity.Match) error
+	CreateBatch(ctx context.Context, matches []entity.Match) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Match, error)
 	FindByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error)
 	Update(ctx context.Context, match *entity.Match) error
@@ -19,7 +20,25 @@ type MatchRepository interface {
 	FindByDateRange(ctx context.Context, startDate, endDate time.Time, page, limit int) ([]entity.Match, int64, error)
 	FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error)
 	FindByStatus(ctx context.Context, status entity.MatchStatus, page, limit int) ([]entity.Match, int64, error)
+	FindBySeasonID(ctx context.Context, seasonID uuid.UUID, page, limit int) ([]entity.Match, int64, error)
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
-	GetTeamWinCount(ctx context.Context, teamID uuid.UUID, isHome bool) (int64, error)
-	GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
+	// CountWinsByTeams counts each team's completed wins, home and away, in a
+	// single query; teams without a win are left out of the map
+	CountWinsByTeams(ctx context.Context, teamIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+	GetCompletedMatches(ctx context.Context, seasonID *uuid.UUID, page, limit int) ([]entity.Match, int64, error)
+	FindAllCompleted(ctx context.Context, seasonID *uuid.UUID) ([]entity.Match, error)
+	FindByGroupID(ctx context.Context, groupID uuid.UUID) ([]entity.Match, error)
+	FindPlayedByPlayer(ctx context.Context, playerID uuid.UUID) ([]entity.Match, error)
+	// FindCompletedByTeam returns the team's completed matches played between
+	// from and to, inclusive, oldest first; a nil bound leaves that end open
+	FindCompletedByTeam(ctx context.Context, teamID uuid.UUID, from, to *time.Time) ([]entity.Match, error)
+	// FindCompletedBetweenTeams returns the completed meetings of two teams
+	// with either team at home, most recent first
+	FindCompletedBetweenTeams(ctx context.Context, teamA, teamB uuid.UUID) ([]entity.Match, error)
+	// FindForScheduling returns the matches that are not cancelled, dated
+	// between from and to inclusive, that involve any of the teams or the venue
+	FindForScheduling(ctx context.Context, from, to time.Time, teamIDs []uuid.UUID, venueID *uuid.UUID) ([]entity.Match, error)
+	// CountCompletedByTeamBetween counts the team's completed matches kicking
+	// off after one match and, when before is set, before another
+	CountCompletedByTeamBetween(ctx context.Context, teamID uuid.UUID, after, before *entity.Match) (int64, error)
 }
@@ -0,0 +1,22 @@
+package repository
+
+import "context"
+
+// Message represents a payload published on a topic
+type Message struct {
+	Topic string
+	Data  []byte
+}
+
+// Publisher delivers messages to the subscribers of a topic
+type Publisher interface {
+	// Publish delivers data to every current subscriber of the topic
+	Publish(ctx context.Context, topic string, data []byte) error
+}
+
+// Subscriber follows the messages published on a topic
+type Subscriber interface {
+	// Subscribe returns a channel receiving the topic's messages until ctx
+	// is done, after which the channel is closed
+	Subscribe(ctx context.Context, topic string) (<-chan Message, error)
+}
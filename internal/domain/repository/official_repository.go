@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// OfficialRepository defines the interface for match official data operations
+type OfficialRepository interface {
+	Create(ctx context.Context, official *entity.Official) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Official, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.Official, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Official, error)
+	Update(ctx context.Context, official *entity.Official) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context, page, limit int) ([]entity.Official, int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// FindAssignmentsByMatchID returns the officials assigned to the match
+	FindAssignmentsByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error)
+	// FindAssignmentsByOfficialID returns the official's assignments in
+	// kickoff order with their matches, optionally limited to a date range
+	FindAssignmentsByOfficialID(ctx context.Context, officialID uuid.UUID, startDate, endDate *time.Time) ([]entity.MatchOfficial, error)
+	// FindAssignmentsOnDate returns the assignments of any of the officials to
+	// matches on the date that are not cancelled, other than the given match
+	FindAssignmentsOnDate(ctx context.Context, officialIDs []uuid.UUID, date time.Time, excludeMatchID uuid.UUID) ([]entity.MatchOfficial, error)
+	CreateAssignments(ctx context.Context, assignments []entity.MatchOfficial) error
+	UpdateAssignment(ctx context.Context, assignment *entity.MatchOfficial) error
+	DeleteAssignmentsByMatchID(ctx context.Context, matchID uuid.UUID) error
+}
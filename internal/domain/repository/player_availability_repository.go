@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// PlayerAvailabilityRepository defines the interface for player availability data operations
+type PlayerAvailabilityRepository interface {
+	Create(ctx context.Context, availability *entity.PlayerAvailability) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.PlayerAvailability, error)
+	Update(ctx context.Context, availability *entity.PlayerAvailability) error
+	FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error)
+	// FindActiveByTeamID returns the records that keep the team's players out on the date
+	FindActiveByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time) ([]entity.PlayerAvailability, error)
+}
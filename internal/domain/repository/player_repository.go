@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -16,6 +17,7 @@ type PlayerRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error)
 	FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error)
+	FindAvailableByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time, page, limit int) ([]entity.Player, int64, error)
 	IsJerseyNumberTaken(ctx context.Context, teamID uuid.UUID, jerseyNumber int, excludePlayerID *uuid.UUID) (bool, error)
 	Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error)
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// RatingRepository defines the interface for team rating data operations
+type RatingRepository interface {
+	// FindAll returns every rated team, highest rating first
+	FindAll(ctx context.Context) ([]entity.TeamRating, error)
+	FindByTeamIDs(ctx context.Context, teamIDs []uuid.UUID) ([]entity.TeamRating, error)
+	// FindHistoryByTeamID returns the team's rating changes in match order
+	FindHistoryByTeamID(ctx context.Context, teamID uuid.UUID) ([]entity.RatingHistory, error)
+	// FindLatestHistory returns a rating change from the most recent rated
+	// match, with the match preloaded
+	FindLatestHistory(ctx context.Context) (*entity.RatingHistory, error)
+	// Apply saves the teams' new ratings and the history entries behind them
+	Apply(ctx context.Context, ratings []entity.TeamRating, history []entity.RatingHistory) error
+	// ReplaceAll swaps every rating and history entry for the given ones
+	ReplaceAll(ctx context.Context, ratings []entity.TeamRating, history []entity.RatingHistory) error
+}
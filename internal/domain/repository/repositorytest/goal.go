@@ -0,0 +1,181 @@
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// GoalRepository checks a repository.GoalRepository implementation
+func GoalRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("FindByMatchIDOrdersByMinute", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		scorer := createPlayer(t, repos, 2, home, "Marko Simic", 9)
+		match := createMatch(t, repos, home, away, date(1, 10), "15:00")
+		other := createMatch(t, repos, away, home, date(1, 17), "15:00")
+
+		goals := []entity.Goal{
+			{MatchID: match.ID, PlayerID: scorer.ID, TeamID: home.ID, Minute: 70},
+			{MatchID: match.ID, PlayerID: scorer.ID, TeamID: home.ID, Minute: 5},
+			{MatchID: other.ID, PlayerID: scorer.ID, TeamID: home.ID, Minute: 30},
+		}
+		if err := repos.Goals.CreateBatch(ctx, goals); err != nil {
+			t.Fatal(err)
+		}
+		if err := repos.Goals.CreateBatch(ctx, nil); err != nil {
+			t.Fatalf("empty batch: %v", err)
+		}
+
+		found, err := repos.Goals.FindByMatchID(ctx, match.ID)
+		if err != nil || len(found) != 2 || found[0].Minute != 5 || found[1].Minute != 70 {
+			t.Fatalf("got %+v, %v, want the minute 5 and 70 goals", found, err)
+		}
+		if found[0].Player == nil || found[0].Player.Name != scorer.Name || found[0].Team == nil || found[0].Team.Name != home.Name {
+			t.Fatalf("FindByMatchID did not load the scorer and team: %+v", found[0])
+		}
+
+		goal, err := repos.Goals.FindByID(ctx, found[0].ID)
+		if err != nil || goal.Minute != 5 || goal.MatchID != match.ID {
+			t.Fatalf("FindByID got %+v, %v", goal, err)
+		}
+		_, err = repos.Goals.FindByID(ctx, uuid.New())
+		requireNotFound(t, err)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		scorer := createPlayer(t, repos, 2, home, "Marko Simic", 9)
+		goal := createGoal(t, repos, createMatch(t, repos, home, away, date(1, 10), "15:00"), scorer, 45, false)
+
+		goal.StoppageTime = 2
+		goal.IsPenalty = true
+		if err := repos.Goals.Update(ctx, goal); err != nil {
+			t.Fatal(err)
+		}
+		found, err := repos.Goals.FindByID(ctx, goal.ID)
+		if err != nil || found.StoppageTime != 2 || !found.IsPenalty {
+			t.Fatalf("got %+v, %v", found, err)
+		}
+	})
+
+	t.Run("FindByPlayerIDWithinDates", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		scorer := createPlayer(t, repos, 2, home, "Marko Simic", 9)
+		for _, day := range []int{3, 10, 17, 24} {
+			createGoal(t, repos, createMatch(t, repos, home, away, date(1, day), "15:00"), scorer, day, false)
+		}
+
+		from, to := date(1, 10), date(1, 17)
+		cases := []struct {
+			name    string
+			from    *time.Time
+			to      *time.Time
+			minutes string
+		}{
+			{"open", nil, nil, "[3 10 17 24]"},
+			{"from", &from, nil, "[10 17 24]"},
+			{"to", nil, &to, "[3 10 17]"},
+			{"between", &from, &to, "[10 17]"},
+		}
+		for _, tc := range cases {
+			goals, err := repos.Goals.FindByPlayerID(ctx, scorer.ID, tc.from, tc.to)
+			minutes := make([]int, len(goals))
+			for i, goal := range goals {
+				minutes[i] = goal.Minute
+			}
+			if err != nil || fmt.Sprint(minutes) != tc.minutes {
+				t.Errorf("%s: got minutes %v, %v, want %s", tc.name, minutes, err, tc.minutes)
+			}
+		}
+	})
+
+	t.Run("SoftDeleteHides", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		scorer := createPlayer(t, repos, 2, home, "Marko Simic", 9)
+		match := createMatch(t, repos, home, away, date(1, 10), "15:00")
+		other := createMatch(t, repos, away, home, date(1, 17), "15:00")
+		kept := createGoal(t, repos, match, scorer, 10, false)
+		deleted := createGoal(t, repos, match, scorer, 20, false)
+		createGoal(t, repos, other, scorer, 30, false)
+		createGoal(t, repos, other, scorer, 40, false)
+
+		if err := repos.Goals.Delete(ctx, deleted.ID); err != nil {
+			t.Fatal(err)
+		}
+		_, err := repos.Goals.FindByID(ctx, deleted.ID)
+		requireNotFound(t, err)
+		goals, err := repos.Goals.FindByMatchID(ctx, match.ID)
+		if err != nil || len(goals) != 1 || goals[0].ID != kept.ID {
+			t.Fatalf("got %+v, %v, want only the kept goal", goals, err)
+		}
+
+		if err := repos.Goals.DeleteByMatchID(ctx, other.ID); err != nil {
+			t.Fatal(err)
+		}
+		goals, err = repos.Goals.FindByMatchID(ctx, other.ID)
+		if err != nil || len(goals) != 0 {
+			t.Fatalf("got %+v, %v, want no goals", goals, err)
+		}
+		goals, err = repos.Goals.FindByPlayerID(ctx, scorer.ID, nil, nil)
+		if err != nil || len(goals) != 1 {
+			t.Fatalf("got %+v, %v, want only the kept goal", goals, err)
+		}
+	})
+
+	t.Run("GetTopScorers", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		striker := createPlayer(t, repos, 2, home, "Marko Simic", 9)
+		winger := createPlayer(t, repos, 3, away, "David da Silva", 19)
+		defender := createPlayer(t, repos, 4, away, "Nick Kuipers", 4)
+		released := createPlayer(t, repos, 5, home, "Ezra Walian", 11)
+		first := createMatch(t, repos, home, away, date(1, 10), "15:00")
+		second := createMatch(t, repos, away, home, date(1, 17), "15:00")
+
+		createGoal(t, repos, first, striker, 10, false)
+		createGoal(t, repos, first, striker, 20, false)
+		createGoal(t, repos, second, striker, 30, false)
+		createGoal(t, repos, second, winger, 40, false)
+		createGoal(t, repos, second, winger, 50, false)
+		// Own goals are not credited to the scorer
+		for minute := 60; minute < 65; minute++ {
+			createGoal(t, repos, second, defender, minute, true)
+		}
+		for minute := 70; minute < 75; minute++ {
+			createGoal(t, repos, first, released, minute, false)
+		}
+		if err := repos.Players.Delete(ctx, released.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		scorers, err := repos.Goals.GetTopScorers(ctx, nil, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(scorers) != 2 ||
+			scorers[0].PlayerID != striker.ID || scorers[0].PlayerName != striker.Name || scorers[0].TeamID != home.ID || scorers[0].TeamName != home.Name || scorers[0].GoalCount != 3 ||
+			scorers[1].PlayerID != winger.ID || scorers[1].TeamName != away.Name || scorers[1].GoalCount != 2 {
+			t.Fatalf("got %+v, want %s with 3 then %s with 2", scorers, striker.Name, winger.Name)
+		}
+
+		scorers, err = repos.Goals.GetTopScorers(ctx, nil, 1)
+		if err != nil || len(scorers) != 1 || scorers[0].PlayerID != striker.ID {
+			t.Fatalf("limit 1 got %+v, %v", scorers, err)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// MatchRepository checks a repository.MatchRepository implementation
+func MatchRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("CreateAndFind", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		match := createMatch(t, repos, home, away, date(1, 10), "15:00")
+		scorer := createPlayer(t, repos, 2, home, "Marko Simic", 9)
+		createGoal(t, repos, match, scorer, 10, false)
+
+		found, err := repos.Matches.FindByID(ctx, match.ID)
+		if err != nil || matchKickoff(*found) != "01-10 15:00" || found.HomeTeamID != home.ID || found.Status != entity.MatchStatusScheduled || found.HomeScore != nil {
+			t.Fatalf("FindByID got %+v, %v", found, err)
+		}
+
+		found, err = repos.Matches.FindByIDWithDetails(ctx, match.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found.HomeTeam == nil || found.HomeTeam.Name != home.Name || found.AwayTeam == nil || found.AwayTeam.Name != away.Name {
+			t.Fatalf("FindByIDWithDetails did not load the teams: %+v", found)
+		}
+		if len(found.Goals) != 1 || found.Goals[0].Player == nil || found.Goals[0].Player.Name != scorer.Name {
+			t.Fatalf("FindByIDWithDetails did not load the goals: %+v", found.Goals)
+		}
+
+		_, err = repos.Matches.FindByID(ctx, uuid.New())
+		requireNotFound(t, err)
+	})
+
+	t.Run("CreateBatch", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+
+		matches := []entity.Match{
+			{MatchDate: date(1, 10), MatchTime: "15:00", HomeTeamID: home.ID, AwayTeamID: away.ID, Status: entity.MatchStatusScheduled},
+			{MatchDate: date(1, 17), MatchTime: "15:00", HomeTeamID: away.ID, AwayTeamID: home.ID, Status: entity.MatchStatusScheduled},
+		}
+		if err := repos.Matches.CreateBatch(ctx, matches); err != nil {
+			t.Fatal(err)
+		}
+		if err := repos.Matches.CreateBatch(ctx, nil); err != nil {
+			t.Fatalf("empty batch: %v", err)
+		}
+
+		found, total, err := repos.Matches.FindAll(ctx, 1, 10)
+		requirePage(t, found, total, err, matchKickoff, 2, "01-17 15:00", "01-10 15:00")
+	})
+
+	t.Run("FindAllPaginatesLatestFirst", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		createMatch(t, repos, home, away, date(1, 10), "15:00")
+		createMatch(t, repos, home, away, date(1, 10), "19:00")
+		createMatch(t, repos, home, away, date(1, 3), "15:00")
+
+		matches, total, err := repos.Matches.FindAll(ctx, 1, 2)
+		requirePage(t, matches, total, err, matchKickoff, 3, "01-10 19:00", "01-10 15:00")
+		matches, total, err = repos.Matches.FindAll(ctx, 2, 2)
+		requirePage(t, matches, total, err, matchKickoff, 3, "01-03 15:00")
+		if matches[0].HomeTeam == nil || matches[0].HomeTeam.Name != home.Name {
+			t.Fatalf("FindAll did not load the teams: %+v", matches[0])
+		}
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		repos := newRepos(t)
+		a := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		b := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		c := createTeam(t, repos, 2, "Arema FC", "Malang")
+		createMatch(t, repos, a, b, date(1, 1), "15:00")
+		played := createMatch(t, repos, b, c, date(1, 8), "15:00")
+		completeMatch(t, repos, played, 1, 0)
+		createMatch(t, repos, c, a, date(1, 15), "15:00")
+		createMatch(t, repos, b, a, date(1, 31), "15:00")
+
+		matches, total, err := repos.Matches.FindByDateRange(ctx, date(1, 8), date(1, 31), 1, 10)
+		requirePage(t, matches, total, err, matchKickoff, 3, "01-08 15:00", "01-15 15:00", "01-31 15:00")
+		matches, total, err = repos.Matches.FindByDateRange(ctx, date(1, 8), date(1, 31), 2, 2)
+		requirePage(t, matches, total, err, matchKickoff, 3, "01-31 15:00")
+
+		matches, total, err = repos.Matches.FindByTeamID(ctx, a.ID, 1, 10)
+		requirePage(t, matches, total, err, matchKickoff, 3, "01-31 15:00", "01-15 15:00", "01-01 15:00")
+
+		matches, total, err = repos.Matches.FindByStatus(ctx, entity.MatchStatusScheduled, 1, 10)
+		requirePage(t, matches, total, err, matchKickoff, 3, "01-01 15:00", "01-15 15:00", "01-31 15:00")
+
+		matches, total, err = repos.Matches.GetCompletedMatches(ctx, nil, 1, 10)
+		requirePage(t, matches, total, err, matchKickoff, 1, "01-08 15:00")
+	})
+
+	t.Run("CountWinsByTeams", func(t *testing.T) {
+		repos := newRepos(t)
+		a := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		b := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		c := createTeam(t, repos, 2, "Arema FC", "Malang")
+		completeMatch(t, repos, createMatch(t, repos, a, b, date(1, 1), "15:00"), 2, 0)
+		completeMatch(t, repos, createMatch(t, repos, b, a, date(1, 8), "15:00"), 0, 1)
+		completeMatch(t, repos, createMatch(t, repos, b, c, date(1, 15), "15:00"), 3, 1)
+		completeMatch(t, repos, createMatch(t, repos, c, a, date(1, 22), "15:00"), 1, 1)
+		createMatch(t, repos, a, c, date(1, 29), "15:00")
+
+		wins, err := repos.Matches.CountWinsByTeams(ctx, []uuid.UUID{a.ID, b.ID, c.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wins[a.ID] != 2 || wins[b.ID] != 1 || wins[c.ID] != 0 {
+			t.Fatalf("got %v, want 2, 1 and 0 wins", wins)
+		}
+
+		wins, err = repos.Matches.CountWinsByTeams(ctx, []uuid.UUID{b.ID})
+		if err != nil || len(wins) != 1 || wins[b.ID] != 1 {
+			t.Fatalf("got %v, %v, want only team b's win", wins, err)
+		}
+	})
+
+	t.Run("SoftDeleteHides", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		kept := createMatch(t, repos, home, away, date(1, 10), "15:00")
+		deleted := createMatch(t, repos, away, home, date(1, 17), "15:00")
+		completeMatch(t, repos, deleted, 1, 0)
+
+		if err := repos.Matches.Delete(ctx, deleted.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err := repos.Matches.FindByID(ctx, deleted.ID)
+		requireNotFound(t, err)
+		exists, err := repos.Matches.Exists(ctx, deleted.ID)
+		if err != nil || exists {
+			t.Fatalf("Exists of a deleted match got %v, %v", exists, err)
+		}
+		want := matchKickoff(*kept)
+		matches, total, err := repos.Matches.FindAll(ctx, 1, 10)
+		requirePage(t, matches, total, err, matchKickoff, 1, want)
+		matches, total, err = repos.Matches.FindByTeamID(ctx, home.ID, 1, 10)
+		requirePage(t, matches, total, err, matchKickoff, 1, want)
+		matches, total, err = repos.Matches.GetCompletedMatches(ctx, nil, 1, 10)
+		requirePage(t, matches, total, err, matchKickoff, 0)
+		wins, err := repos.Matches.CountWinsByTeams(ctx, []uuid.UUID{away.ID})
+		if err != nil || wins[away.ID] != 0 {
+			t.Fatalf("wins in a deleted match got %v, %v", wins, err)
+		}
+	})
+}
@@ -0,0 +1,179 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// PlayerRepository checks a repository.PlayerRepository implementation
+func PlayerRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("CreateAndFind", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		player := createPlayer(t, repos, 1, team, "Marko Simic", 9)
+
+		found, err := repos.Players.FindByID(ctx, player.ID)
+		if err != nil || found.Name != player.Name || found.TeamID != team.ID || found.JerseyNumber != 9 || found.Position != player.Position {
+			t.Fatalf("FindByID got %+v, %v", found, err)
+		}
+		found, err = repos.Players.FindByIDWithTeam(ctx, player.ID)
+		if err != nil || found.Team == nil || found.Team.Name != team.Name {
+			t.Fatalf("FindByIDWithTeam got %+v, %v", found, err)
+		}
+
+		_, err = repos.Players.FindByID(ctx, uuid.New())
+		requireNotFound(t, err)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		player := createPlayer(t, repos, 1, team, "Marko Simic", 9)
+
+		player.JerseyNumber = 10
+		if err := repos.Players.Update(ctx, player); err != nil {
+			t.Fatal(err)
+		}
+		found, err := repos.Players.FindByID(ctx, player.ID)
+		if err != nil || found.JerseyNumber != 10 {
+			t.Fatalf("got %+v, %v", found, err)
+		}
+	})
+
+	t.Run("FindAllPaginatesNewestFirst", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		for i, name := range []string{"A", "B", "C"} {
+			createPlayer(t, repos, i+1, team, name, i+1)
+		}
+
+		players, total, err := repos.Players.FindAll(ctx, 1, 2)
+		requirePage(t, players, total, err, playerName, 3, "C", "B")
+		players, total, err = repos.Players.FindAll(ctx, 2, 2)
+		requirePage(t, players, total, err, playerName, 3, "A")
+	})
+
+	t.Run("FindByTeamIDOrdersByJersey", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		other := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		createPlayer(t, repos, 2, team, "Striker", 9)
+		createPlayer(t, repos, 3, team, "Keeper", 1)
+		createPlayer(t, repos, 4, team, "Winger", 7)
+		createPlayer(t, repos, 5, other, "Other", 2)
+
+		players, total, err := repos.Players.FindByTeamID(ctx, team.ID, 1, 2)
+		requirePage(t, players, total, err, playerName, 3, "Keeper", "Winger")
+		players, total, err = repos.Players.FindByTeamID(ctx, team.ID, 2, 2)
+		requirePage(t, players, total, err, playerName, 3, "Striker")
+	})
+
+	t.Run("JerseyNumberIsUniquePerTeam", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		other := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		player := createPlayer(t, repos, 2, team, "Marko Simic", 9)
+
+		cases := []struct {
+			name    string
+			teamID  uuid.UUID
+			jersey  int
+			exclude *uuid.UUID
+			want    bool
+		}{
+			{"same team", team.ID, 9, nil, true},
+			{"free number", team.ID, 10, nil, false},
+			{"other team", other.ID, 9, nil, false},
+			{"holder excluded", team.ID, 9, &player.ID, false},
+		}
+		for _, tc := range cases {
+			taken, err := repos.Players.IsJerseyNumberTaken(ctx, tc.teamID, tc.jersey, tc.exclude)
+			if err != nil || taken != tc.want {
+				t.Errorf("%s: got %v, %v, want %v", tc.name, taken, err, tc.want)
+			}
+		}
+
+		if err := repos.Players.Delete(ctx, player.ID); err != nil {
+			t.Fatal(err)
+		}
+		taken, err := repos.Players.IsJerseyNumberTaken(ctx, team.ID, 9, nil)
+		if err != nil || taken {
+			t.Fatalf("number of a deleted player got %v, %v, want free", taken, err)
+		}
+	})
+
+	t.Run("SearchIgnoresCase", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		createPlayer(t, repos, 1, team, "Marko Simic", 9)
+		createPlayer(t, repos, 2, team, "Riko Simanjuntak", 25)
+		createPlayer(t, repos, 3, team, "Andritany Ardhiyasa", 1)
+
+		players, total, err := repos.Players.Search(ctx, "SIM", 1, 10)
+		requirePage(t, players, total, err, playerName, 2, "Riko Simanjuntak", "Marko Simic")
+		players, total, err = repos.Players.Search(ctx, "sim", 2, 1)
+		requirePage(t, players, total, err, playerName, 2, "Marko Simic")
+	})
+
+	t.Run("SoftDeleteHides", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		kept := createPlayer(t, repos, 1, team, "Marko Simic", 9)
+		deleted := createPlayer(t, repos, 2, team, "Riko Simanjuntak", 25)
+
+		if err := repos.Players.Delete(ctx, deleted.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err := repos.Players.FindByID(ctx, deleted.ID)
+		requireNotFound(t, err)
+		exists, err := repos.Players.Exists(ctx, deleted.ID)
+		if err != nil || exists {
+			t.Fatalf("Exists of a deleted player got %v, %v", exists, err)
+		}
+		players, total, err := repos.Players.FindAll(ctx, 1, 10)
+		requirePage(t, players, total, err, playerName, 1, kept.Name)
+		players, total, err = repos.Players.FindByTeamID(ctx, team.ID, 1, 10)
+		requirePage(t, players, total, err, playerName, 1, kept.Name)
+		players, total, err = repos.Players.Search(ctx, "riko", 1, 10)
+		requirePage(t, players, total, err, playerName, 0)
+	})
+
+	t.Run("GetTopScorers", func(t *testing.T) {
+		repos := newRepos(t)
+		home := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		away := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		hatTrick := createPlayer(t, repos, 2, home, "Marko Simic", 9)
+		brace := createPlayer(t, repos, 3, away, "David da Silva", 19)
+		single := createPlayer(t, repos, 4, home, "Riko Simanjuntak", 25)
+		released := createPlayer(t, repos, 5, away, "Ezra Walian", 11)
+		match := createMatch(t, repos, home, away, date(1, 10), "15:00")
+
+		for minute := 1; minute <= 3; minute++ {
+			createGoal(t, repos, match, hatTrick, minute, false)
+		}
+		createGoal(t, repos, match, brace, 10, false)
+		createGoal(t, repos, match, brace, 11, false)
+		createGoal(t, repos, match, single, 20, false)
+		for minute := 30; minute < 34; minute++ {
+			createGoal(t, repos, match, released, minute, false)
+		}
+		if err := repos.Players.Delete(ctx, released.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		scorers, err := repos.Players.GetTopScorers(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(scorers) != 2 ||
+			scorers[0].Player.ID != hatTrick.ID || scorers[0].Player.Name != hatTrick.Name || scorers[0].GoalCount != 3 ||
+			scorers[1].Player.ID != brace.ID || scorers[1].GoalCount != 2 {
+			t.Fatalf("got %+v, want %s with 3 then %s with 2", scorers, hatTrick.Name, brace.Name)
+		}
+	})
+}
@@ -0,0 +1,171 @@
+// Package repositorytest is a contract test suite for the repository
+// interfaces. Every implementation runs the same suite, so a new backend or a
+// query change is held to the same expectations.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// Repositories is a set of repositories sharing one store
+type Repositories struct {
+	Users   repository.UserRepository
+	Teams   repository.TeamRepository
+	Players repository.PlayerRepository
+	Matches repository.MatchRepository
+	Goals   repository.GoalRepository
+}
+
+// Factory returns repositories over a new, empty store
+type Factory func(t *testing.T) Repositories
+
+// Run runs every contract against the repositories newRepos creates
+func Run(t *testing.T, newRepos Factory) {
+	t.Run("UserRepository", func(t *testing.T) { UserRepository(t, newRepos) })
+	t.Run("TeamRepository", func(t *testing.T) { TeamRepository(t, newRepos) })
+	t.Run("PlayerRepository", func(t *testing.T) { PlayerRepository(t, newRepos) })
+	t.Run("MatchRepository", func(t *testing.T) { MatchRepository(t, newRepos) })
+	t.Run("GoalRepository", func(t *testing.T) { GoalRepository(t, newRepos) })
+}
+
+// baseTime is when the first fixture was created; later fixtures are created
+// an hour apart so "newest first" orderings are deterministic
+var baseTime = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func createdAt(i int) time.Time {
+	return baseTime.Add(time.Duration(i) * time.Hour)
+}
+
+// date returns midnight UTC of a day in 2025
+func date(month time.Month, day int) time.Time {
+	return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func createTeam(t *testing.T, repos Repositories, i int, name, city string) *entity.Team {
+	t.Helper()
+	team := &entity.Team{
+		BaseEntity:  entity.BaseEntity{CreatedAt: createdAt(i)},
+		Name:        name,
+		City:        city,
+		FoundedYear: 1900 + i,
+	}
+	if err := repos.Teams.Create(context.Background(), team); err != nil {
+		t.Fatalf("create team %s: %v", name, err)
+	}
+	return team
+}
+
+func createPlayer(t *testing.T, repos Repositories, i int, team *entity.Team, name string, jersey int) *entity.Player {
+	t.Helper()
+	player := &entity.Player{
+		BaseEntity:   entity.BaseEntity{CreatedAt: createdAt(i)},
+		TeamID:       team.ID,
+		Name:         name,
+		Height:       180,
+		Weight:       75,
+		Position:     entity.PositionForward,
+		JerseyNumber: jersey,
+	}
+	if err := repos.Players.Create(context.Background(), player); err != nil {
+		t.Fatalf("create player %s: %v", name, err)
+	}
+	return player
+}
+
+func createMatch(t *testing.T, repos Repositories, home, away *entity.Team, matchDate time.Time, matchTime string) *entity.Match {
+	t.Helper()
+	match := &entity.Match{
+		MatchDate:  matchDate,
+		MatchTime:  matchTime,
+		HomeTeamID: home.ID,
+		AwayTeamID: away.ID,
+		Status:     entity.MatchStatusScheduled,
+	}
+	if err := repos.Matches.Create(context.Background(), match); err != nil {
+		t.Fatalf("create match: %v", err)
+	}
+	return match
+}
+
+func completeMatch(t *testing.T, repos Repositories, match *entity.Match, homeScore, awayScore int) {
+	t.Helper()
+	match.Status = entity.MatchStatusCompleted
+	match.HomeScore = &homeScore
+	match.AwayScore = &awayScore
+	if err := repos.Matches.Update(context.Background(), match); err != nil {
+		t.Fatalf("complete match: %v", err)
+	}
+}
+
+func createGoal(t *testing.T, repos Repositories, match *entity.Match, player *entity.Player, minute int, ownGoal bool) *entity.Goal {
+	t.Helper()
+	goal := &entity.Goal{
+		MatchID:   match.ID,
+		PlayerID:  player.ID,
+		TeamID:    player.TeamID,
+		Minute:    minute,
+		IsOwnGoal: ownGoal,
+	}
+	if err := repos.Goals.Create(context.Background(), goal); err != nil {
+		t.Fatalf("create goal: %v", err)
+	}
+	return goal
+}
+
+func newUser(i int, email string) *entity.User {
+	return &entity.User{
+		BaseEntity: entity.BaseEntity{CreatedAt: createdAt(i)},
+		Email:      email,
+		Password:   "hashed",
+		Name:       "User " + email,
+		Role:       entity.RoleUser,
+	}
+}
+
+func createUser(t *testing.T, repos Repositories, i int, email string) *entity.User {
+	t.Helper()
+	user := newUser(i, email)
+	if err := repos.Users.Create(context.Background(), user); err != nil {
+		t.Fatalf("create user %s: %v", email, err)
+	}
+	return user
+}
+
+// requireNotFound fails unless err reports a missing record
+func requireNotFound(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("got error %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
+
+// requirePage fails unless a page holds the wanted names, in order, out of
+// the wanted total
+func requirePage[T any](t *testing.T, items []T, total int64, err error, name func(T) string, wantTotal int64, want ...string) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make([]string, len(items))
+	for i, item := range items {
+		got[i] = name(item)
+	}
+	if total != wantTotal || fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v of %d, want %v of %d", got, total, want, wantTotal)
+	}
+}
+
+func teamName(team entity.Team) string       { return team.Name }
+func playerName(player entity.Player) string { return player.Name }
+func userEmail(user entity.User) string      { return user.Email }
+func matchKickoff(match entity.Match) string {
+	return match.MatchDate.UTC().Format("01-02") + " " + match.MatchTime
+}
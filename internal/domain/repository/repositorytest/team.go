@@ -0,0 +1,122 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// TeamRepository checks a repository.TeamRepository implementation
+func TeamRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("CreateAndFind", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		if team.ID == uuid.Nil {
+			t.Fatal("Create did not assign an ID")
+		}
+
+		found, err := repos.Teams.FindByID(ctx, team.ID)
+		if err != nil || found.Name != team.Name || found.City != team.City || found.FoundedYear != team.FoundedYear {
+			t.Fatalf("got %+v, %v", found, err)
+		}
+
+		_, err = repos.Teams.FindByID(ctx, uuid.New())
+		requireNotFound(t, err)
+
+		exists, err := repos.Teams.Exists(ctx, team.ID)
+		if err != nil || !exists {
+			t.Fatalf("Exists got %v, %v", exists, err)
+		}
+		exists, err = repos.Teams.Exists(ctx, uuid.New())
+		if err != nil || exists {
+			t.Fatalf("Exists of an unknown team got %v, %v", exists, err)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+
+		team.City = "Jakarta Pusat"
+		if err := repos.Teams.Update(ctx, team); err != nil {
+			t.Fatal(err)
+		}
+		found, err := repos.Teams.FindByID(ctx, team.ID)
+		if err != nil || found.City != "Jakarta Pusat" {
+			t.Fatalf("got %+v, %v", found, err)
+		}
+	})
+
+	t.Run("FindByIDWithPlayers", func(t *testing.T) {
+		repos := newRepos(t)
+		team := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		createPlayer(t, repos, 1, team, "Marko Simic", 9)
+		released := createPlayer(t, repos, 2, team, "Riko Simanjuntak", 25)
+		if err := repos.Players.Delete(ctx, released.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		found, err := repos.Teams.FindByIDWithPlayers(ctx, team.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found.Players) != 1 || found.Players[0].Name != "Marko Simic" {
+			t.Fatalf("got players %+v, want only Marko Simic", found.Players)
+		}
+	})
+
+	t.Run("FindAllPaginatesNewestFirst", func(t *testing.T) {
+		repos := newRepos(t)
+		for i, name := range []string{"A", "B", "C", "D", "E"} {
+			createTeam(t, repos, i, name, "Jakarta")
+		}
+
+		teams, total, err := repos.Teams.FindAll(ctx, 1, 2)
+		requirePage(t, teams, total, err, teamName, 5, "E", "D")
+		teams, total, err = repos.Teams.FindAll(ctx, 3, 2)
+		requirePage(t, teams, total, err, teamName, 5, "A")
+		teams, total, err = repos.Teams.FindAll(ctx, 4, 2)
+		requirePage(t, teams, total, err, teamName, 5)
+	})
+
+	t.Run("SearchMatchesNameOrCityIgnoringCase", func(t *testing.T) {
+		repos := newRepos(t)
+		createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+		createTeam(t, repos, 2, "Bhayangkara FC", "Jakarta")
+		createTeam(t, repos, 3, "Arema FC", "Malang")
+
+		teams, total, err := repos.Teams.Search(ctx, "JAKARTA", 1, 10)
+		requirePage(t, teams, total, err, teamName, 2, "Bhayangkara FC", "Persija Jakarta")
+		teams, total, err = repos.Teams.Search(ctx, "fc", 1, 1)
+		requirePage(t, teams, total, err, teamName, 2, "Arema FC")
+		teams, total, err = repos.Teams.Search(ctx, "fc", 2, 1)
+		requirePage(t, teams, total, err, teamName, 2, "Bhayangkara FC")
+		teams, total, err = repos.Teams.Search(ctx, "Surabaya", 1, 10)
+		requirePage(t, teams, total, err, teamName, 0)
+	})
+
+	t.Run("SoftDeleteHides", func(t *testing.T) {
+		repos := newRepos(t)
+		kept := createTeam(t, repos, 0, "Persija Jakarta", "Jakarta")
+		deleted := createTeam(t, repos, 1, "Persib Bandung", "Bandung")
+
+		if err := repos.Teams.Delete(ctx, deleted.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err := repos.Teams.FindByID(ctx, deleted.ID)
+		requireNotFound(t, err)
+		exists, err := repos.Teams.Exists(ctx, deleted.ID)
+		if err != nil || exists {
+			t.Fatalf("Exists of a deleted team got %v, %v", exists, err)
+		}
+		teams, total, err := repos.Teams.FindAll(ctx, 1, 10)
+		requirePage(t, teams, total, err, teamName, 1, kept.Name)
+		teams, total, err = repos.Teams.Search(ctx, "persib", 1, 10)
+		requirePage(t, teams, total, err, teamName, 0)
+	})
+}
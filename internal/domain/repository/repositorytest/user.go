@@ -0,0 +1,82 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+)
+
+// UserRepository checks a repository.UserRepository implementation
+func UserRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("CreateAndFind", func(t *testing.T) {
+		repos := newRepos(t)
+		user := createUser(t, repos, 0, "budi@ayofootball.com")
+
+		found, err := repos.Users.FindByID(ctx, user.ID)
+		if err != nil || found.Email != user.Email || found.Role != user.Role {
+			t.Fatalf("FindByID got %+v, %v", found, err)
+		}
+		found, err = repos.Users.FindByEmail(ctx, user.Email)
+		if err != nil || found.ID != user.ID {
+			t.Fatalf("FindByEmail got %+v, %v", found, err)
+		}
+
+		_, err = repos.Users.FindByEmail(ctx, "nobody@ayofootball.com")
+		requireNotFound(t, err)
+	})
+
+	t.Run("EmailIsUnique", func(t *testing.T) {
+		repos := newRepos(t)
+		createUser(t, repos, 0, "budi@ayofootball.com")
+
+		if err := repos.Users.Create(ctx, newUser(1, "budi@ayofootball.com")); err == nil {
+			t.Fatal("created a second user with the same email")
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repos := newRepos(t)
+		user := createUser(t, repos, 0, "budi@ayofootball.com")
+
+		user.Name = "Budi Santoso"
+		if err := repos.Users.Update(ctx, user); err != nil {
+			t.Fatal(err)
+		}
+		found, err := repos.Users.FindByID(ctx, user.ID)
+		if err != nil || found.Name != "Budi Santoso" {
+			t.Fatalf("got %+v, %v", found, err)
+		}
+	})
+
+	t.Run("FindAllPaginatesNewestFirst", func(t *testing.T) {
+		repos := newRepos(t)
+		for i, email := range []string{"a@x.com", "b@x.com", "c@x.com"} {
+			createUser(t, repos, i, email)
+		}
+
+		users, total, err := repos.Users.FindAll(ctx, 1, 2)
+		requirePage(t, users, total, err, userEmail, 3, "c@x.com", "b@x.com")
+		users, total, err = repos.Users.FindAll(ctx, 2, 2)
+		requirePage(t, users, total, err, userEmail, 3, "a@x.com")
+		users, total, err = repos.Users.FindAll(ctx, 3, 2)
+		requirePage(t, users, total, err, userEmail, 3)
+	})
+
+	t.Run("SoftDeleteHides", func(t *testing.T) {
+		repos := newRepos(t)
+		kept := createUser(t, repos, 0, "kept@x.com")
+		deleted := createUser(t, repos, 1, "deleted@x.com")
+
+		if err := repos.Users.Delete(ctx, deleted.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err := repos.Users.FindByID(ctx, deleted.ID)
+		requireNotFound(t, err)
+		_, err = repos.Users.FindByEmail(ctx, deleted.Email)
+		requireNotFound(t, err)
+		users, total, err := repos.Users.FindAll(ctx, 1, 10)
+		requirePage(t, users, total, err, userEmail, 1, kept.Email)
+	})
+}
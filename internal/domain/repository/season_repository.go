@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// SeasonRepository defines the interface for season data operations
+type SeasonRepository interface {
+	Create(ctx context.Context, season *entity.Season) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Season, error)
+	Update(ctx context.Context, season *entity.Season) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByCompetitionID(ctx context.Context, competitionID uuid.UUID, page, limit int) ([]entity.Season, int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// SuspensionRepository defines the interface for suspension ledger data operations
+type SuspensionRepository interface {
+	// ReplaceForPlayer swaps the player's whole ledger for the given suspensions
+	ReplaceForPlayer(ctx context.Context, playerID uuid.UUID, suspensions []entity.Suspension) error
+	FindByPlayerIDs(ctx context.Context, playerIDs []uuid.UUID) ([]entity.Suspension, error)
+	FindAll(ctx context.Context, teamID *uuid.UUID) ([]entity.Suspension, error)
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// Transactor runs a unit of work inside a single database transaction.
+// Repository calls made with the context passed to fn take part in the
+// transaction, which is rolled back when fn returns an error.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
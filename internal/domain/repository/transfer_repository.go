@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// TransferRepository defines the interface for player transfer data operations
+type TransferRepository interface {
+	Create(ctx context.Context, transfer *entity.Transfer) error
+	FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Transfer, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// VenueRepository defines the interface for venue data operations
+type VenueRepository interface {
+	Create(ctx context.Context, venue *entity.Venue) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error)
+	Update(ctx context.Context, venue *entity.Venue) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindAll(ctx context.Context, page, limit int) ([]entity.Venue, int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+}
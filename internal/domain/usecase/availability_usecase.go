@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAvailabilityNotFound      = errors.New("availability record not found")
+	ErrInvalidAvailabilityStatus = errors.New("availability status must be injured, ill or unavailable")
+	ErrInvalidAvailabilityDates  = errors.New("return dates cannot be before the start date")
+	ErrAvailabilityOverlap       = errors.New("player is already unavailable on the start date")
+	ErrAvailabilityClosed        = errors.New("availability record is already closed")
+)
+
+// AvailabilityUseCase defines the interface for player availability operations
+type AvailabilityUseCase interface {
+	Create(ctx context.Context, availability *entity.PlayerAvailability) error
+	// Close records the date the player returned
+	Close(ctx context.Context, playerID, availabilityID uuid.UUID, endDate time.Time) (*entity.PlayerAvailability, error)
+	GetByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error)
+	// GetUnavailableByTeamID returns the records keeping the team's players out on the date
+	GetUnavailableByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time) ([]entity.PlayerAvailability, error)
+}
+
+type availabilityUseCaseImpl struct {
+	availabilityRepo repository.PlayerAvailabilityRepository
+	playerRepo       repository.PlayerRepository
+	teamRepo         repository.TeamRepository
+}
+
+// NewAvailabilityUseCase creates a new instance of AvailabilityUseCase
+func NewAvailabilityUseCase(
+	availabilityRepo repository.PlayerAvailabilityRepository,
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+) AvailabilityUseCase {
+	return &availabilityUseCaseImpl{
+		availabilityRepo: availabilityRepo,
+		playerRepo:       playerRepo,
+		teamRepo:         teamRepo,
+	}
+}
+
+func (uc *availabilityUseCaseImpl) Create(ctx context.Context, availability *entity.PlayerAvailability) error {
+	if !entity.IsValidAvailabilityStatus(availability.Status) {
+		return ErrInvalidAvailabilityStatus
+	}
+	if availability.ExpectedReturn != nil && availability.ExpectedReturn.Before(availability.StartDate) {
+		return ErrInvalidAvailabilityDates
+	}
+
+	existing, err := uc.GetByPlayerID(ctx, availability.PlayerID)
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if other.IsActiveOn(availability.StartDate) {
+			return ErrAvailabilityOverlap
+		}
+	}
+
+	availability.EndDate = nil
+	return uc.availabilityRepo.Create(ctx, availability)
+}
+
+func (uc *availabilityUseCaseImpl) Close(ctx context.Context, playerID, availabilityID uuid.UUID, endDate time.Time) (*entity.PlayerAvailability, error) {
+	availability, err := uc.availabilityRepo.FindByID(ctx, availabilityID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAvailabilityNotFound
+		}
+		return nil, err
+	}
+	if availability.PlayerID != playerID {
+		return nil, ErrAvailabilityNotFound
+	}
+	if availability.IsClosed() {
+		return nil, ErrAvailabilityClosed
+	}
+	if endDate.Before(availability.StartDate) {
+		return nil, ErrInvalidAvailabilityDates
+	}
+
+	availability.EndDate = &endDate
+	if err := uc.availabilityRepo.Update(ctx, availability); err != nil {
+		return nil, err
+	}
+	return availability, nil
+}
+
+func (uc *availabilityUseCaseImpl) GetByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error) {
+	exists, err := uc.playerRepo.Exists(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrPlayerNotFound
+	}
+	return uc.availabilityRepo.FindByPlayerID(ctx, playerID)
+}
+
+func (uc *availabilityUseCaseImpl) GetUnavailableByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time) ([]entity.PlayerAvailability, error) {
+	exists, err := uc.teamRepo.Exists(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+	return uc.availabilityRepo.FindActiveByTeamID(ctx, teamID, date)
+}
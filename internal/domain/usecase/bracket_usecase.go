@@ -0,0 +1,482 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrBracketNotFound        = errors.New("bracket not found")
+	ErrInvalidBracketSchedule = errors.New("round and leg intervals must be at least one day")
+	ErrTieUndecided           = errors.New("a knockout tie cannot end level; record extra time or a penalty shootout")
+	ErrUnexpectedPenalties    = errors.New("a penalty shootout can only follow the deciding leg of a level tie")
+	ErrFirstLegNotPlayed      = errors.New("the first leg must be completed before the second leg")
+	ErrBracketAlreadyAdvanced = errors.New("the winner has already played in the next round")
+	ErrBracketAlreadyPlayed   = errors.New("bracket already has matches that have been played")
+)
+
+// BracketInput represents the input for creating a knockout bracket
+type BracketInput struct {
+	Name              string
+	SeasonID          *uuid.UUID
+	TeamIDs           []uuid.UUID // Seed order, top seed first
+	TwoLegged         bool
+	TwoLeggedFinal    bool
+	StartDate         time.Time
+	KickoffTime       string // Format: HH:MM
+	RoundIntervalDays int
+	LegIntervalDays   int
+}
+
+// BracketUseCase defines the interface for knockout bracket operations.
+// It observes match results so winners advance automatically.
+type BracketUseCase interface {
+	MatchResultObserver
+	Create(ctx context.Context, input BracketInput) (*entity.Bracket, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Bracket, error)
+	GetAll(ctx context.Context, page, limit int) ([]entity.Bracket, int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type bracketUseCaseImpl struct {
+	bracketRepo repository.BracketRepository
+	matchRepo   repository.MatchRepository
+	teamRepo    repository.TeamRepository
+	seasonRepo  repository.SeasonRepository
+	transactor  repository.Transactor
+	scheduler   MatchScheduler
+}
+
+// NewBracketUseCase creates a new instance of BracketUseCase
+func NewBracketUseCase(
+	bracketRepo repository.BracketRepository,
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	seasonRepo repository.SeasonRepository,
+	transactor repository.Transactor,
+	scheduler MatchScheduler,
+) BracketUseCase {
+	return &bracketUseCaseImpl{
+		bracketRepo: bracketRepo,
+		matchRepo:   matchRepo,
+		teamRepo:    teamRepo,
+		seasonRepo:  seasonRepo,
+		transactor:  transactor,
+		scheduler:   scheduler,
+	}
+}
+
+func (uc *bracketUseCaseImpl) Create(ctx context.Context, input BracketInput) (*entity.Bracket, error) {
+	if err := uc.validateInput(ctx, input); err != nil {
+		return nil, err
+	}
+
+	bracket := &entity.Bracket{
+		BaseEntity:        entity.BaseEntity{ID: uuid.New()},
+		SeasonID:          input.SeasonID,
+		Name:              input.Name,
+		TwoLegged:         input.TwoLegged,
+		TwoLeggedFinal:    input.TwoLeggedFinal,
+		StartDate:         input.StartDate,
+		KickoffTime:       input.KickoffTime,
+		RoundIntervalDays: input.RoundIntervalDays,
+		LegIntervalDays:   input.LegIntervalDays,
+	}
+	bracket.Ties = SeedBracket(bracket, input.TeamIDs)
+
+	// Schedule every tie whose teams are already known
+	var matches []entity.Match
+	for i := range bracket.Ties {
+		tie := &bracket.Ties[i]
+		if tie.IsBye || tie.HomeTeamID == nil || tie.AwayTeamID == nil {
+			continue
+		}
+		matches = append(matches, scheduleLegs(bracket, tie, bracket.RoundDate(tie.Round))...)
+	}
+	if err := prepareForSchedule(ctx, uc.scheduler, matches); err != nil {
+		return nil, err
+	}
+
+	// Store the bracket and its first matches together
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if len(matches) > 0 {
+			if err := uc.matchRepo.CreateBatch(ctx, matches); err != nil {
+				return err
+			}
+		}
+		return uc.bracketRepo.Create(ctx, bracket)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.GetByID(ctx, bracket.ID)
+}
+
+func (uc *bracketUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Bracket, error) {
+	bracket, err := uc.bracketRepo.FindByIDWithTies(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBracketNotFound
+		}
+		return nil, err
+	}
+	return bracket, nil
+}
+
+func (uc *bracketUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Bracket, int64, error) {
+	return uc.bracketRepo.FindAll(ctx, page, limit)
+}
+
+// Delete removes the bracket with the matches it scheduled, so it can only be
+// deleted before any of them kicks off
+func (uc *bracketUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	bracket, err := uc.bracketRepo.FindByIDWithTies(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBracketNotFound
+		}
+		return err
+	}
+
+	var matchIDs []uuid.UUID
+	for _, tie := range bracket.Ties {
+		for _, leg := range []*entity.Match{tie.FirstLeg, tie.SecondLeg} {
+			if leg == nil {
+				continue
+			}
+			if leg.Status == entity.MatchStatusOngoing || leg.Status == entity.MatchStatusCompleted {
+				return ErrBracketAlreadyPlayed
+			}
+			matchIDs = append(matchIDs, leg.ID)
+		}
+	}
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.bracketRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		for _, matchID := range matchIDs {
+			if err := uc.matchRepo.Delete(ctx, matchID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (uc *bracketUseCaseImpl) ValidateResult(ctx context.Context, match *entity.Match) error {
+	tie, err := uc.bracketRepo.FindTieByMatchID(ctx, match.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Not a knockout match
+			return nil
+		}
+		return err
+	}
+
+	// Judge the tie with the incoming result in place of the stored one
+	if tie.FirstLegMatchID != nil && *tie.FirstLegMatchID == match.ID {
+		tie.FirstLeg = match
+	} else {
+		tie.SecondLeg = match
+	}
+
+	deciding := tie.DecidingLeg()
+	if deciding.ID != match.ID && match.GetPenaltyResult() != "" {
+		return ErrUnexpectedPenalties
+	}
+	if tie.TwoLegged && deciding.ID == match.ID && tie.FirstLeg.Status != entity.MatchStatusCompleted {
+		return ErrFirstLegNotPlayed
+	}
+
+	home, away, ok := tie.Aggregate()
+	if !ok {
+		// The first leg of a two-legged tie settles nothing on its own
+		return nil
+	}
+	if home != away && deciding.GetPenaltyResult() != "" {
+		return ErrUnexpectedPenalties
+	}
+
+	winner := tie.DetermineWinner()
+	if winner == nil {
+		return ErrTieUndecided
+	}
+
+	// Changing a result may only swap the winner while the next round is unplayed
+	if tie.WinnerTeamID != nil && *tie.WinnerTeamID != *winner {
+		return uc.ensureNextRoundUnplayed(ctx, tie)
+	}
+
+	return nil
+}
+
+func (uc *bracketUseCaseImpl) ResultRecorded(ctx context.Context, match *entity.Match) error {
+	tie, err := uc.bracketRepo.FindTieByMatchID(ctx, match.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	winner := tie.DetermineWinner()
+	if winner == nil {
+		return nil
+	}
+	if tie.WinnerTeamID != nil && *tie.WinnerTeamID == *winner {
+		return nil
+	}
+
+	tie.WinnerTeamID = winner
+	if err := uc.bracketRepo.UpdateTie(ctx, tie); err != nil {
+		return err
+	}
+
+	return uc.advance(ctx, tie, *winner, match.MatchDate)
+}
+
+// advance places the winner of a tie into the next round, scheduling the
+// next-round matches once both teams are known
+func (uc *bracketUseCaseImpl) advance(ctx context.Context, tie *entity.BracketTie, winner uuid.UUID, playedOn time.Time) error {
+	bracket, err := uc.bracketRepo.FindByID(ctx, tie.BracketID)
+	if err != nil {
+		return err
+	}
+	if tie.Round == bracket.Rounds {
+		// The final has been decided
+		return nil
+	}
+
+	next, err := uc.bracketRepo.FindTie(ctx, bracket.ID, tie.Round+1, tie.Slot/2)
+	if err != nil {
+		return err
+	}
+	placeWinner(next, tie.Slot, winner)
+
+	if next.FirstLegMatchID != nil {
+		// The winner changed after the next round was drawn
+		if err := uc.reassignLegs(ctx, next); err != nil {
+			return err
+		}
+	} else if next.HomeTeamID != nil && next.AwayTeamID != nil {
+		date := bracket.RoundDate(next.Round)
+		if !date.After(playedOn) {
+			date = playedOn.AddDate(0, 0, bracket.RoundIntervalDays)
+		}
+		legs := scheduleLegs(bracket, next, date)
+		if err := prepareForSchedule(ctx, uc.scheduler, legs); err != nil {
+			return err
+		}
+		if err := uc.matchRepo.CreateBatch(ctx, legs); err != nil {
+			return err
+		}
+	}
+
+	return uc.bracketRepo.UpdateTie(ctx, next)
+}
+
+// reassignLegs updates the scheduled legs of a tie to its current teams
+func (uc *bracketUseCaseImpl) reassignLegs(ctx context.Context, tie *entity.BracketTie) error {
+	legs := []*uuid.UUID{tie.FirstLegMatchID, tie.SecondLegMatchID}
+	for i, legID := range legs {
+		if legID == nil {
+			continue
+		}
+		match, err := uc.matchRepo.FindByID(ctx, *legID)
+		if err != nil {
+			return err
+		}
+		match.HomeTeamID, match.AwayTeamID = *tie.HomeTeamID, *tie.AwayTeamID
+		if i == 1 {
+			// The away team hosts the second leg
+			match.HomeTeamID, match.AwayTeamID = match.AwayTeamID, match.HomeTeamID
+		}
+		if err := uc.matchRepo.Update(ctx, match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureNextRoundUnplayed rejects changes to a tie whose winner has already
+// played in the following round
+func (uc *bracketUseCaseImpl) ensureNextRoundUnplayed(ctx context.Context, tie *entity.BracketTie) error {
+	bracket, err := uc.bracketRepo.FindByID(ctx, tie.BracketID)
+	if err != nil {
+		return err
+	}
+	if tie.Round == bracket.Rounds {
+		return nil
+	}
+
+	next, err := uc.bracketRepo.FindTie(ctx, bracket.ID, tie.Round+1, tie.Slot/2)
+	if err != nil {
+		return err
+	}
+	for _, leg := range []*entity.Match{next.FirstLeg, next.SecondLeg} {
+		if leg != nil && leg.Status == entity.MatchStatusCompleted {
+			return ErrBracketAlreadyAdvanced
+		}
+	}
+	return nil
+}
+
+// validateInput checks the season, teams and scheduling options
+func (uc *bracketUseCaseImpl) validateInput(ctx context.Context, input BracketInput) error {
+	if input.SeasonID != nil {
+		exists, err := uc.seasonRepo.Exists(ctx, *input.SeasonID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrSeasonNotFound
+		}
+	}
+
+	if len(input.TeamIDs) < 2 {
+		return ErrNotEnoughTeams
+	}
+	if _, err := time.Parse("15:04", input.KickoffTime); err != nil {
+		return ErrInvalidKickoffTime
+	}
+	if input.RoundIntervalDays < 1 || input.LegIntervalDays < 1 {
+		return ErrInvalidBracketSchedule
+	}
+
+	seen := make(map[uuid.UUID]bool, len(input.TeamIDs))
+	for _, teamID := range input.TeamIDs {
+		if seen[teamID] {
+			return ErrDuplicateTeam
+		}
+		seen[teamID] = true
+
+		exists, err := uc.teamRepo.Exists(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrTeamNotFound
+		}
+	}
+
+	return nil
+}
+
+// SeedBracket lays out every tie of a knockout bracket for teams given in
+// seed order and sets the bracket's number of rounds. Seeds are drawn in the
+// standard pattern (1 v 8, 4 v 5, 2 v 7, 3 v 6, ...) so the top two seeds can
+// only meet in the final. When the field is not a power of two the highest
+// seeds receive first-round byes and go straight into the second round.
+func SeedBracket(bracket *entity.Bracket, teamIDs []uuid.UUID) []entity.BracketTie {
+	size, rounds := 1, 0
+	for size < len(teamIDs) {
+		size *= 2
+		rounds++
+	}
+	bracket.Rounds = rounds
+
+	// ties[r-1][slot] holds the tie in round r
+	ties := make([][]entity.BracketTie, rounds)
+	for r := 1; r <= rounds; r++ {
+		ties[r-1] = make([]entity.BracketTie, size>>r)
+		for slot := range ties[r-1] {
+			ties[r-1][slot] = entity.BracketTie{
+				BracketID: bracket.ID,
+				Round:     r,
+				Slot:      slot,
+				TwoLegged: bracket.IsTwoLeggedRound(r),
+			}
+		}
+	}
+
+	order := seedOrder(size)
+	for slot := range ties[0] {
+		tie := &ties[0][slot]
+		high, low := order[2*slot], order[2*slot+1]
+		highTeam := teamIDs[high-1]
+		tie.HomeSeed, tie.HomeTeamID = &high, &highTeam
+
+		if low > len(teamIDs) {
+			tie.IsBye = true
+			tie.WinnerTeamID = tie.HomeTeamID
+			placeWinner(&ties[1][slot/2], slot, highTeam)
+			continue
+		}
+
+		lowTeam := teamIDs[low-1]
+		tie.AwaySeed, tie.AwayTeamID = &low, &lowTeam
+		if tie.TwoLegged {
+			// The higher seed hosts the second leg
+			tie.HomeSeed, tie.AwaySeed = tie.AwaySeed, tie.HomeSeed
+			tie.HomeTeamID, tie.AwayTeamID = tie.AwayTeamID, tie.HomeTeamID
+		}
+	}
+
+	var all []entity.BracketTie
+	for _, round := range ties {
+		all = append(all, round...)
+	}
+	return all
+}
+
+// seedOrder returns the seeds of a bracket of the given size in slot order,
+// so that consecutive pairs are first-round opponents
+func seedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		for _, seed := range order {
+			next = append(next, seed, len(order)*2+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// placeWinner puts the winner of the tie in fromSlot into the next-round tie.
+// Winners from even slots take the home side, odd slots the away side.
+func placeWinner(next *entity.BracketTie, fromSlot int, teamID uuid.UUID) {
+	if fromSlot%2 == 0 {
+		next.HomeTeamID = &teamID
+	} else {
+		next.AwayTeamID = &teamID
+	}
+}
+
+// scheduleLegs creates the matches for a tie whose teams are known and links
+// them to the tie. The first leg is played on the given date.
+func scheduleLegs(bracket *entity.Bracket, tie *entity.BracketTie, date time.Time) []entity.Match {
+	first := entity.Match{
+		BaseEntity: entity.BaseEntity{ID: uuid.New()},
+		MatchDate:  date,
+		MatchTime:  bracket.KickoffTime,
+		HomeTeamID: *tie.HomeTeamID,
+		AwayTeamID: *tie.AwayTeamID,
+		SeasonID:   bracket.SeasonID,
+		Status:     entity.MatchStatusScheduled,
+	}
+	tie.FirstLegMatchID = &first.ID
+	if !tie.TwoLegged {
+		return []entity.Match{first}
+	}
+
+	second := entity.Match{
+		BaseEntity: entity.BaseEntity{ID: uuid.New()},
+		MatchDate:  date.AddDate(0, 0, bracket.LegIntervalDays),
+		MatchTime:  bracket.KickoffTime,
+		HomeTeamID: *tie.AwayTeamID,
+		AwayTeamID: *tie.HomeTeamID,
+		SeasonID:   bracket.SeasonID,
+		Status:     entity.MatchStatusScheduled,
+	}
+	tie.SecondLegMatchID = &second.ID
+	return []entity.Match{first, second}
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCompetitionNotFound    = errors.New("competition not found")
+	ErrInvalidCompetitionType = errors.New("invalid competition type")
+	ErrSeasonNotFound         = errors.New("season not found")
+	ErrInvalidSeasonDates     = errors.New("season end date must not be before start date")
+)
+
+// CompetitionUseCase defines the interface for competition and season operations
+type CompetitionUseCase interface {
+	Create(ctx context.Context, competition *entity.Competition) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Competition, error)
+	GetByIDWithSeasons(ctx context.Context, id uuid.UUID) (*entity.Competition, error)
+	Update(ctx context.Context, competition *entity.Competition) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetAll(ctx context.Context, page, limit int) ([]entity.Competition, int64, error)
+
+	CreateSeason(ctx context.Context, season *entity.Season) error
+	GetSeason(ctx context.Context, competitionID, seasonID uuid.UUID) (*entity.Season, error)
+	UpdateSeason(ctx context.Context, season *entity.Season) error
+	DeleteSeason(ctx context.Context, competitionID, seasonID uuid.UUID) error
+	GetSeasons(ctx context.Context, competitionID uuid.UUID, page, limit int) ([]entity.Season, int64, error)
+}
+
+type competitionUseCaseImpl struct {
+	competitionRepo repository.CompetitionRepository
+	seasonRepo      repository.SeasonRepository
+}
+
+// NewCompetitionUseCase creates a new instance of CompetitionUseCase
+func NewCompetitionUseCase(
+	competitionRepo repository.CompetitionRepository,
+	seasonRepo repository.SeasonRepository,
+) CompetitionUseCase {
+	return &competitionUseCaseImpl{
+		competitionRepo: competitionRepo,
+		seasonRepo:      seasonRepo,
+	}
+}
+
+func (uc *competitionUseCaseImpl) Create(ctx context.Context, competition *entity.Competition) error {
+	if competition.Type == "" {
+		competition.Type = entity.CompetitionTypeLeague
+	}
+	if !entity.IsValidCompetitionType(competition.Type) {
+		return ErrInvalidCompetitionType
+	}
+	return uc.competitionRepo.Create(ctx, competition)
+}
+
+func (uc *competitionUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Competition, error) {
+	competition, err := uc.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCompetitionNotFound
+		}
+		return nil, err
+	}
+	return competition, nil
+}
+
+func (uc *competitionUseCaseImpl) GetByIDWithSeasons(ctx context.Context, id uuid.UUID) (*entity.Competition, error) {
+	competition, err := uc.competitionRepo.FindByIDWithSeasons(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCompetitionNotFound
+		}
+		return nil, err
+	}
+	return competition, nil
+}
+
+func (uc *competitionUseCaseImpl) Update(ctx context.Context, competition *entity.Competition) error {
+	exists, err := uc.competitionRepo.Exists(ctx, competition.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrCompetitionNotFound
+	}
+	if !entity.IsValidCompetitionType(competition.Type) {
+		return ErrInvalidCompetitionType
+	}
+	return uc.competitionRepo.Update(ctx, competition)
+}
+
+func (uc *competitionUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	exists, err := uc.competitionRepo.Exists(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrCompetitionNotFound
+	}
+	return uc.competitionRepo.Delete(ctx, id)
+}
+
+func (uc *competitionUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Competition, int64, error) {
+	return uc.competitionRepo.FindAll(ctx, page, limit)
+}
+
+func (uc *competitionUseCaseImpl) CreateSeason(ctx context.Context, season *entity.Season) error {
+	exists, err := uc.competitionRepo.Exists(ctx, season.CompetitionID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrCompetitionNotFound
+	}
+	if season.EndDate.Before(season.StartDate) {
+		return ErrInvalidSeasonDates
+	}
+	return uc.seasonRepo.Create(ctx, season)
+}
+
+func (uc *competitionUseCaseImpl) GetSeason(ctx context.Context, competitionID, seasonID uuid.UUID) (*entity.Season, error) {
+	season, err := uc.seasonRepo.FindByID(ctx, seasonID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSeasonNotFound
+		}
+		return nil, err
+	}
+
+	// A season is only addressable through the competition it belongs to
+	if season.CompetitionID != competitionID {
+		return nil, ErrSeasonNotFound
+	}
+
+	return season, nil
+}
+
+func (uc *competitionUseCaseImpl) UpdateSeason(ctx context.Context, season *entity.Season) error {
+	if _, err := uc.GetSeason(ctx, season.CompetitionID, season.ID); err != nil {
+		return err
+	}
+	if season.EndDate.Before(season.StartDate) {
+		return ErrInvalidSeasonDates
+	}
+	return uc.seasonRepo.Update(ctx, season)
+}
+
+func (uc *competitionUseCaseImpl) DeleteSeason(ctx context.Context, competitionID, seasonID uuid.UUID) error {
+	if _, err := uc.GetSeason(ctx, competitionID, seasonID); err != nil {
+		return err
+	}
+	return uc.seasonRepo.Delete(ctx, seasonID)
+}
+
+func (uc *competitionUseCaseImpl) GetSeasons(ctx context.Context, competitionID uuid.UUID, page, limit int) ([]entity.Season, int64, error) {
+	exists, err := uc.competitionRepo.Exists(ctx, competitionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, ErrCompetitionNotFound
+	}
+	return uc.seasonRepo.FindByCompetitionID(ctx, competitionID, page, limit)
+}
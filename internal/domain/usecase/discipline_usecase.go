@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+var (
+	ErrPlayerSuspended        = errors.New("player is suspended for this match")
+	ErrInvalidSuspensionRules = errors.New("suspension thresholds and bans must be non-negative")
+	ErrInvalidEnforcement     = errors.New("suspension enforcement must be reject or warn")
+)
+
+// SuspensionEnforcement decides what happens when a suspended player is named
+type SuspensionEnforcement string
+
+const (
+	EnforcementReject SuspensionEnforcement = "reject"
+	EnforcementWarn   SuspensionEnforcement = "warn"
+)
+
+// SuspensionRules defines how many matches each card costs a player
+type SuspensionRules struct {
+	YellowCardThreshold int // Yellow cards that add up to a ban, 0 disables accumulation
+	YellowCardBan       int
+	SecondYellowBan     int
+	RedCardBan          int
+	Enforcement         SuspensionEnforcement
+}
+
+// Validate checks the suspension rules
+func (r SuspensionRules) Validate() error {
+	if r.YellowCardThreshold < 0 || r.YellowCardBan < 0 || r.SecondYellowBan < 0 || r.RedCardBan < 0 {
+		return ErrInvalidSuspensionRules
+	}
+	if r.Enforcement != EnforcementReject && r.Enforcement != EnforcementWarn {
+		return ErrInvalidEnforcement
+	}
+	return nil
+}
+
+// SuspensionStatus represents a suspension with how much of it is served
+type SuspensionStatus struct {
+	Suspension entity.Suspension
+	Served     int
+	Remaining  int
+}
+
+// DisciplineRecord represents a player's cards and suspensions
+type DisciplineRecord struct {
+	Player        *entity.Player
+	Team          *entity.Team
+	YellowCards   int
+	SecondYellows int
+	RedCards      int
+	Suspensions   []SuspensionStatus
+	Suspended     bool // Whether the player still has matches to serve
+}
+
+// DisciplineUseCase defines the interface for the card and suspension ledger
+type DisciplineUseCase interface {
+	// SyncPlayer rebuilds the player's suspensions from their cards
+	SyncPlayer(ctx context.Context, playerID uuid.UUID) error
+	// CheckEligibility fails, or warns under EnforcementWarn, when any of the
+	// players is serving a suspension at the time of the match
+	CheckEligibility(ctx context.Context, match *entity.Match, playerIDs []uuid.UUID) error
+	GetReport(ctx context.Context, teamID *uuid.UUID) ([]DisciplineRecord, error)
+}
+
+type disciplineUseCaseImpl struct {
+	suspensionRepo repository.SuspensionRepository
+	eventRepo      repository.MatchEventRepository
+	matchRepo      repository.MatchRepository
+	teamRepo       repository.TeamRepository
+	rules          SuspensionRules
+}
+
+// NewDisciplineUseCase creates a new instance of DisciplineUseCase
+func NewDisciplineUseCase(
+	suspensionRepo repository.SuspensionRepository,
+	eventRepo repository.MatchEventRepository,
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	rules SuspensionRules,
+) DisciplineUseCase {
+	return &disciplineUseCaseImpl{
+		suspensionRepo: suspensionRepo,
+		eventRepo:      eventRepo,
+		matchRepo:      matchRepo,
+		teamRepo:       teamRepo,
+		rules:          rules,
+	}
+}
+
+func (uc *disciplineUseCaseImpl) SyncPlayer(ctx context.Context, playerID uuid.UUID) error {
+	cards, err := uc.eventRepo.FindCardsByPlayerID(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	// Yellows that led to a second yellow are part of that sending off and
+	// do not count towards accumulation
+	sentOffIn := make(map[uuid.UUID]bool)
+	for _, card := range cards {
+		if card.Type == entity.MatchEventSecondYellow {
+			sentOffIn[card.MatchID] = true
+		}
+	}
+
+	var suspensions []entity.Suspension
+	yellows := 0
+	for _, card := range cards {
+		reason, matches := entity.SuspensionReason(""), 0
+		switch card.Type {
+		case entity.MatchEventYellowCard:
+			if sentOffIn[card.MatchID] || uc.rules.YellowCardThreshold == 0 {
+				continue
+			}
+			yellows++
+			if yellows%uc.rules.YellowCardThreshold == 0 {
+				reason, matches = entity.SuspensionYellowAccumulation, uc.rules.YellowCardBan
+			}
+		case entity.MatchEventSecondYellow:
+			reason, matches = entity.SuspensionSecondYellow, uc.rules.SecondYellowBan
+		case entity.MatchEventRedCard:
+			reason, matches = entity.SuspensionRedCard, uc.rules.RedCardBan
+		}
+		if matches == 0 {
+			continue
+		}
+
+		suspensions = append(suspensions, entity.Suspension{
+			PlayerID: playerID,
+			TeamID:   card.TeamID,
+			MatchID:  card.MatchID,
+			EventID:  card.ID,
+			Reason:   reason,
+			Matches:  matches,
+		})
+	}
+
+	return uc.suspensionRepo.ReplaceForPlayer(ctx, playerID, suspensions)
+}
+
+func (uc *disciplineUseCaseImpl) CheckEligibility(ctx context.Context, match *entity.Match, playerIDs []uuid.UUID) error {
+	suspensions, err := uc.suspensionRepo.FindByPlayerIDs(ctx, playerIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, suspension := range suspensions {
+		if suspension.Match == nil || suspension.MatchID == match.ID || !suspension.Match.KicksOffBefore(match) {
+			continue
+		}
+
+		served, err := uc.matchRepo.CountCompletedByTeamBetween(ctx, suspension.TeamID, suspension.Match, match)
+		if err != nil {
+			return err
+		}
+		if int(served) >= suspension.Matches {
+			continue
+		}
+
+		name := suspension.PlayerID.String()
+		if suspension.Player != nil {
+			name = suspension.Player.Name
+		}
+		if uc.rules.Enforcement == EnforcementWarn {
+			log.Printf("Warning: Suspended player %s named for match %s", name, match.ID)
+			continue
+		}
+		return fmt.Errorf("%w: %s", ErrPlayerSuspended, name)
+	}
+
+	return nil
+}
+
+func (uc *disciplineUseCaseImpl) GetReport(ctx context.Context, teamID *uuid.UUID) ([]DisciplineRecord, error) {
+	if teamID != nil {
+		exists, err := uc.teamRepo.Exists(ctx, *teamID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrTeamNotFound
+		}
+	}
+
+	cards, err := uc.eventRepo.FindCards(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	suspensions, err := uc.suspensionRepo.FindAll(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[uuid.UUID]*DisciplineRecord)
+	record := func(playerID uuid.UUID, player *entity.Player, team *entity.Team) *DisciplineRecord {
+		r, ok := records[playerID]
+		if !ok {
+			r = &DisciplineRecord{Player: player, Team: team}
+			records[playerID] = r
+		}
+		return r
+	}
+
+	for _, card := range cards {
+		r := record(card.PlayerID, card.Player, card.Team)
+		switch card.Type {
+		case entity.MatchEventYellowCard:
+			r.YellowCards++
+		case entity.MatchEventSecondYellow:
+			r.SecondYellows++
+		case entity.MatchEventRedCard:
+			r.RedCards++
+		}
+	}
+
+	for _, suspension := range suspensions {
+		if suspension.Match == nil {
+			continue
+		}
+		served, err := uc.matchRepo.CountCompletedByTeamBetween(ctx, suspension.TeamID, suspension.Match, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		status := SuspensionStatus{Suspension: suspension, Served: int(served)}
+		if status.Served > suspension.Matches {
+			status.Served = suspension.Matches
+		}
+		status.Remaining = suspension.Matches - status.Served
+
+		r := record(suspension.PlayerID, suspension.Player, suspension.Team)
+		r.Suspensions = append(r.Suspensions, status)
+		if status.Remaining > 0 {
+			r.Suspended = true
+		}
+	}
+
+	result := make([]DisciplineRecord, 0, len(records))
+	for _, r := range records {
+		result = append(result, *r)
+	}
+
+	// Suspended players first, then the most serious records
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Suspended != b.Suspended {
+			return a.Suspended
+		}
+		if a.RedCards+a.SecondYellows != b.RedCards+b.SecondYellows {
+			return a.RedCards+a.SecondYellows > b.RedCards+b.SecondYellows
+		}
+		if a.YellowCards != b.YellowCards {
+			return a.YellowCards > b.YellowCards
+		}
+		return playerName(a.Player) < playerName(b.Player)
+	})
+
+	return result, nil
+}
+
+// playerName returns the player's name, or an empty string when unknown
+func playerName(player *entity.Player) string {
+	if player == nil {
+		return ""
+	}
+	return player.Name
+}
@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNotEnoughTeams     = errors.New("at least two teams are required to generate fixtures")
+	ErrDuplicateTeam      = errors.New("each team may only be listed once")
+	ErrNoMatchDays        = errors.New("at least one match day is required")
+	ErrNoKickoffTimes     = errors.New("at least one kickoff time is required")
+	ErrInvalidKickoffTime = errors.New("kickoff times must use the HH:MM format")
+)
+
+// FixtureInput represents the input for generating a round-robin schedule
+type FixtureInput struct {
+	CompetitionID    uuid.UUID
+	SeasonID         uuid.UUID
+	TeamIDs          []uuid.UUID
+	StartDate        time.Time
+	MatchDays        []time.Weekday
+	KickoffTimes     []string // Format: HH:MM
+	DoubleRoundRobin bool
+	DryRun           bool
+}
+
+// FixtureRound represents a single round of generated matches
+type FixtureRound struct {
+	Round     int
+	MatchDate time.Time
+	Matches   []entity.Match
+}
+
+// Pairing represents a home/away pairing within a round
+type Pairing struct {
+	HomeTeamID uuid.UUID
+	AwayTeamID uuid.UUID
+}
+
+// FixtureUseCase defines the interface for fixture generation
+type FixtureUseCase interface {
+	GenerateRoundRobin(ctx context.Context, input FixtureInput) ([]FixtureRound, error)
+}
+
+type fixtureUseCaseImpl struct {
+	teamRepo   repository.TeamRepository
+	seasonRepo repository.SeasonRepository
+	scheduler  MatchScheduler
+	transactor repository.Transactor
+}
+
+// NewFixtureUseCase creates a new instance of FixtureUseCase
+func NewFixtureUseCase(
+	teamRepo repository.TeamRepository,
+	seasonRepo repository.SeasonRepository,
+	scheduler MatchScheduler,
+	transactor repository.Transactor,
+) FixtureUseCase {
+	return &fixtureUseCaseImpl{
+		teamRepo:   teamRepo,
+		seasonRepo: seasonRepo,
+		scheduler:  scheduler,
+		transactor: transactor,
+	}
+}
+
+func (uc *fixtureUseCaseImpl) GenerateRoundRobin(ctx context.Context, input FixtureInput) ([]FixtureRound, error) {
+	if err := uc.validateInput(ctx, input); err != nil {
+		return nil, err
+	}
+
+	pairings := BuildRoundRobin(input.TeamIDs, input.DoubleRoundRobin)
+	dates := matchDates(input.StartDate, input.MatchDays, len(pairings))
+
+	rounds := make([]FixtureRound, len(pairings))
+	var matches []entity.Match
+	for i, round := range pairings {
+		rounds[i] = FixtureRound{
+			Round:     i + 1,
+			MatchDate: dates[i],
+			Matches:   make([]entity.Match, len(round)),
+		}
+		for j, pairing := range round {
+			seasonID := input.SeasonID
+			rounds[i].Matches[j] = entity.Match{
+				MatchDate:  dates[i],
+				MatchTime:  input.KickoffTimes[j%len(input.KickoffTimes)],
+				HomeTeamID: pairing.HomeTeamID,
+				AwayTeamID: pairing.AwayTeamID,
+				SeasonID:   &seasonID,
+				Status:     entity.MatchStatusScheduled,
+			}
+		}
+		matches = append(matches, rounds[i].Matches...)
+	}
+
+	if input.DryRun {
+		// A preview shows the venues and clashes the schedule would have too
+		if err := prepareForSchedule(ctx, uc.scheduler, matches); err != nil {
+			return nil, err
+		}
+	} else {
+		// Every match is created like a single new match, in one transaction
+		// so a failure leaves no partial schedule
+		err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			for i := range matches {
+				if err := uc.scheduler.Schedule(ctx, &matches[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Copy the venues and generated IDs back into the rounds
+	k := 0
+	for i := range rounds {
+		for j := range rounds[i].Matches {
+			rounds[i].Matches[j] = matches[k]
+			k++
+		}
+	}
+
+	return rounds, nil
+}
+
+// prepareForSchedule prepares generated matches for scheduling in order, so
+// each is checked against the stored matches and the ones before it
+func prepareForSchedule(ctx context.Context, scheduler MatchScheduler, matches []entity.Match) error {
+	for i := range matches {
+		if err := scheduler.PrepareForSchedule(ctx, &matches[i], matches[:i]...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateInput checks the season, teams and scheduling options
+func (uc *fixtureUseCaseImpl) validateInput(ctx context.Context, input FixtureInput) error {
+	if err := ensureSeasonInCompetition(ctx, uc.seasonRepo, input.CompetitionID, input.SeasonID); err != nil {
+		return err
+	}
+
+	if len(input.TeamIDs) < 2 {
+		return ErrNotEnoughTeams
+	}
+	if err := validateSchedule(input.MatchDays, input.KickoffTimes); err != nil {
+		return err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(input.TeamIDs))
+	for _, teamID := range input.TeamIDs {
+		if seen[teamID] {
+			return ErrDuplicateTeam
+		}
+		seen[teamID] = true
+
+		exists, err := uc.teamRepo.Exists(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrTeamNotFound
+		}
+	}
+
+	return nil
+}
+
+// ensureSeasonInCompetition checks that the season exists and belongs to the competition
+func ensureSeasonInCompetition(ctx context.Context, seasonRepo repository.SeasonRepository, competitionID, seasonID uuid.UUID) error {
+	season, err := seasonRepo.FindByID(ctx, seasonID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrSeasonNotFound
+		}
+		return err
+	}
+	if season.CompetitionID != competitionID {
+		return ErrSeasonNotFound
+	}
+	return nil
+}
+
+// validateSchedule checks the match days and kickoff times of a schedule
+func validateSchedule(matchDays []time.Weekday, kickoffTimes []string) error {
+	if len(matchDays) == 0 {
+		return ErrNoMatchDays
+	}
+	if len(kickoffTimes) == 0 {
+		return ErrNoKickoffTimes
+	}
+	for _, kickoff := range kickoffTimes {
+		if _, err := time.Parse("15:04", kickoff); err != nil {
+			return ErrInvalidKickoffTime
+		}
+	}
+	return nil
+}
+
+// BuildRoundRobin pairs every team against every other team using the
+// circle method. One team stays fixed while the others rotate; the fixed
+// team alternates venue each round and the remaining pairs alternate by
+// position, which keeps every team within one home game of its away games.
+// With an odd number of teams one team sits out each round. A double
+// round-robin repeats the schedule with venues swapped.
+func BuildRoundRobin(teamIDs []uuid.UUID, double bool) [][]Pairing {
+	teams := append([]uuid.UUID(nil), teamIDs...)
+	if len(teams)%2 == 1 {
+		// uuid.Nil marks the bye slot
+		teams = append(teams, uuid.Nil)
+	}
+
+	n := len(teams)
+	var rounds [][]Pairing
+	for r := 0; r < n-1; r++ {
+		var round []Pairing
+		for i := 0; i < n/2; i++ {
+			home, away := teams[i], teams[n-1-i]
+			if home == uuid.Nil || away == uuid.Nil {
+				continue
+			}
+			if (i == 0 && r%2 == 1) || (i > 0 && i%2 == 1) {
+				home, away = away, home
+			}
+			round = append(round, Pairing{HomeTeamID: home, AwayTeamID: away})
+		}
+		rounds = append(rounds, round)
+
+		// Rotate every team except the first one position clockwise
+		teams = append([]uuid.UUID{teams[0], teams[n-1]}, teams[1:n-1]...)
+	}
+
+	if double {
+		firstLeg := len(rounds)
+		for r := 0; r < firstLeg; r++ {
+			reverse := make([]Pairing, len(rounds[r]))
+			for i, pairing := range rounds[r] {
+				reverse[i] = Pairing{HomeTeamID: pairing.AwayTeamID, AwayTeamID: pairing.HomeTeamID}
+			}
+			rounds = append(rounds, reverse)
+		}
+	}
+
+	return rounds
+}
+
+// matchDates returns the first count dates on or after start that fall on one of the given weekdays
+func matchDates(start time.Time, weekdays []time.Weekday, count int) []time.Time {
+	allowed := make(map[time.Weekday]bool, len(weekdays))
+	for _, day := range weekdays {
+		allowed[day] = true
+	}
+
+	dates := make([]time.Time, 0, count)
+	for day := start; len(dates) < count; day = day.AddDate(0, 0, 1) {
+		if allowed[day.Weekday()] {
+			dates = append(dates, day)
+		}
+	}
+	return dates
+}
@@ -0,0 +1,91 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"gorm.io/gorm"
+)
+
+func TestFixtureUseCaseGenerateRoundRobin(t *testing.T) {
+	ctx := context.Background()
+	season := &entity.Season{BaseEntity: entity.BaseEntity{ID: uuid.New()}, CompetitionID: uuid.New()}
+
+	// setup stores four teams, each with its own home ground
+	setup := func() (*harness, usecase.FixtureUseCase, usecase.FixtureInput) {
+		h := newHarness()
+		input := usecase.FixtureInput{
+			CompetitionID: season.CompetitionID,
+			SeasonID:      season.ID,
+			StartDate:     kickoff(4).Truncate(24 * time.Hour), // A Saturday
+			KickoffTimes:  []string{"15:00", "19:00"},
+		}
+		for _, name := range []string{"Persija Jakarta", "Persib Bandung", "Arema FC", "Bali United"} {
+			team := h.store.AddTeam(name, "Indonesia", func(team *entity.Team) {
+				venueID := uuid.New()
+				team.HomeVenueID = &venueID
+			})
+			input.TeamIDs = append(input.TeamIDs, team.ID)
+		}
+		scheduler := usecase.NewMatchScheduler(h.matches, h.teams, nil, usecase.SchedulingRules{RestWindow: 48 * time.Hour})
+		return h, usecase.NewFixtureUseCase(h.teams, seasonStub{season: season}, scheduler, h.transactor), input
+	}
+
+	t.Run("StoresTheScheduleAtHomeGrounds", func(t *testing.T) {
+		h, fixtures, input := setup()
+		input.MatchDays = []time.Weekday{time.Saturday}
+
+		rounds, err := fixtures.GenerateRoundRobin(ctx, input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, round := range rounds {
+			for _, match := range round.Matches {
+				home, err := h.teams.FindByID(ctx, match.HomeTeamID)
+				if err != nil || match.ID == uuid.Nil || match.VenueID == nil || *match.VenueID != *home.HomeVenueID {
+					t.Fatalf("got %+v, want a stored match at the home team's ground", match)
+				}
+			}
+		}
+		if _, total, _ := h.matches.FindAll(ctx, 1, 10); total != 6 {
+			t.Fatalf("got %d matches, want 6", total)
+		}
+	})
+
+	t.Run("StoresNothingWhenARoundClashes", func(t *testing.T) {
+		h, fixtures, input := setup()
+		// Sunday's round follows Saturday's within the rest window
+		input.MatchDays = []time.Weekday{time.Saturday, time.Sunday}
+
+		if _, err := fixtures.GenerateRoundRobin(ctx, input); !errors.Is(err, usecase.ErrTeamNotRested) {
+			t.Fatalf("got error %v, want %v", err, usecase.ErrTeamNotRested)
+		}
+		if _, total, _ := h.matches.FindAll(ctx, 1, 10); total != 0 {
+			t.Fatalf("got %d matches, want the schedule rolled back", total)
+		}
+
+		input.DryRun = true
+		if _, err := fixtures.GenerateRoundRobin(ctx, input); !errors.Is(err, usecase.ErrTeamNotRested) {
+			t.Fatalf("got preview error %v, want %v", err, usecase.ErrTeamNotRested)
+		}
+	})
+}
+
+// seasonStub is a SeasonRepository holding a single season
+type seasonStub struct {
+	repository.SeasonRepository
+	season *entity.Season
+}
+
+func (s seasonStub) FindByID(ctx context.Context, id uuid.UUID) (*entity.Season, error) {
+	if id != s.season.ID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.season, nil
+}
@@ -0,0 +1,403 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrGroupNotFound             = errors.New("group not found")
+	ErrTeamAlreadyInGroup        = errors.New("a team can only belong to one group per season")
+	ErrGroupHasMatches           = errors.New("group already has matches")
+	ErrNoGroups                  = errors.New("season has no groups")
+	ErrGroupStageIncomplete      = errors.New("all group matches must be completed first")
+	ErrInvalidQualificationRules = errors.New("qualification rules do not fit the groups")
+	ErrKnockoutAlreadyGenerated  = errors.New("a knockout bracket already exists for this season")
+)
+
+// GroupTable represents a group with its current standings
+type GroupTable struct {
+	Group     entity.Group
+	Standings []LeaderboardEntry
+	Completed bool // Every group match has been played
+}
+
+// QualificationRules decides which teams progress from the group stage.
+// The top QualifiersPerGroup teams of every group qualify, joined by the
+// BestThirdPlaced best teams finishing directly below them across all groups.
+type QualificationRules struct {
+	QualifiersPerGroup int
+	BestThirdPlaced    int
+}
+
+// KnockoutInput represents the input for drawing the knockout stage
+type KnockoutInput struct {
+	CompetitionID uuid.UUID
+	SeasonID      uuid.UUID
+	Rules         QualificationRules
+	Bracket       BracketInput // Teams and season are filled in from the groups
+}
+
+// GroupUseCase defines the interface for group stage operations
+type GroupUseCase interface {
+	Create(ctx context.Context, competitionID uuid.UUID, group *entity.Group) error
+	GetTables(ctx context.Context, competitionID, seasonID uuid.UUID) ([]GroupTable, error)
+	GetTable(ctx context.Context, competitionID, seasonID, groupID uuid.UUID) (*GroupTable, error)
+	Delete(ctx context.Context, competitionID, seasonID, groupID uuid.UUID) error
+	GenerateFixtures(ctx context.Context, input FixtureInput) ([]FixtureRound, error)
+	GenerateKnockout(ctx context.Context, input KnockoutInput) (*entity.Bracket, error)
+}
+
+type groupUseCaseImpl struct {
+	groupRepo      repository.GroupRepository
+	matchRepo      repository.MatchRepository
+	teamRepo       repository.TeamRepository
+	seasonRepo     repository.SeasonRepository
+	bracketRepo    repository.BracketRepository
+	bracketUseCase BracketUseCase
+	scheduler      MatchScheduler
+	transactor     repository.Transactor
+}
+
+// NewGroupUseCase creates a new instance of GroupUseCase
+func NewGroupUseCase(
+	groupRepo repository.GroupRepository,
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	seasonRepo repository.SeasonRepository,
+	bracketRepo repository.BracketRepository,
+	bracketUseCase BracketUseCase,
+	scheduler MatchScheduler,
+	transactor repository.Transactor,
+) GroupUseCase {
+	return &groupUseCaseImpl{
+		groupRepo:      groupRepo,
+		matchRepo:      matchRepo,
+		teamRepo:       teamRepo,
+		seasonRepo:     seasonRepo,
+		bracketRepo:    bracketRepo,
+		bracketUseCase: bracketUseCase,
+		scheduler:      scheduler,
+		transactor:     transactor,
+	}
+}
+
+func (uc *groupUseCaseImpl) Create(ctx context.Context, competitionID uuid.UUID, group *entity.Group) error {
+	if err := ensureSeasonInCompetition(ctx, uc.seasonRepo, competitionID, group.SeasonID); err != nil {
+		return err
+	}
+	if len(group.Teams) < 2 {
+		return ErrNotEnoughTeams
+	}
+
+	groups, err := uc.groupRepo.FindBySeasonID(ctx, group.SeasonID)
+	if err != nil {
+		return err
+	}
+	taken := make(map[uuid.UUID]bool)
+	for _, existing := range groups {
+		for _, teamID := range existing.TeamIDs() {
+			taken[teamID] = true
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(group.Teams))
+	for _, member := range group.Teams {
+		if seen[member.TeamID] {
+			return ErrDuplicateTeam
+		}
+		seen[member.TeamID] = true
+
+		if taken[member.TeamID] {
+			return ErrTeamAlreadyInGroup
+		}
+
+		exists, err := uc.teamRepo.Exists(ctx, member.TeamID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrTeamNotFound
+		}
+	}
+
+	return uc.groupRepo.Create(ctx, group)
+}
+
+func (uc *groupUseCaseImpl) GetTables(ctx context.Context, competitionID, seasonID uuid.UUID) ([]GroupTable, error) {
+	if err := ensureSeasonInCompetition(ctx, uc.seasonRepo, competitionID, seasonID); err != nil {
+		return nil, err
+	}
+
+	groups, err := uc.groupRepo.FindBySeasonID(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]GroupTable, len(groups))
+	for i := range groups {
+		table, err := uc.buildTable(ctx, &groups[i])
+		if err != nil {
+			return nil, err
+		}
+		tables[i] = *table
+	}
+
+	return tables, nil
+}
+
+func (uc *groupUseCaseImpl) GetTable(ctx context.Context, competitionID, seasonID, groupID uuid.UUID) (*GroupTable, error) {
+	group, err := uc.findGroup(ctx, competitionID, seasonID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.buildTable(ctx, group)
+}
+
+func (uc *groupUseCaseImpl) Delete(ctx context.Context, competitionID, seasonID, groupID uuid.UUID) error {
+	if _, err := uc.findGroup(ctx, competitionID, seasonID, groupID); err != nil {
+		return err
+	}
+
+	matches, err := uc.matchRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if len(matches) > 0 {
+		return ErrGroupHasMatches
+	}
+
+	return uc.groupRepo.Delete(ctx, groupID)
+}
+
+// GenerateFixtures schedules a round-robin inside every group of the season.
+// Round n of every group is played on the same match day; input.TeamIDs is
+// ignored because the teams come from the groups.
+func (uc *groupUseCaseImpl) GenerateFixtures(ctx context.Context, input FixtureInput) ([]FixtureRound, error) {
+	if err := ensureSeasonInCompetition(ctx, uc.seasonRepo, input.CompetitionID, input.SeasonID); err != nil {
+		return nil, err
+	}
+	if err := validateSchedule(input.MatchDays, input.KickoffTimes); err != nil {
+		return nil, err
+	}
+
+	groups, err := uc.groupRepo.FindBySeasonID(ctx, input.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, ErrNoGroups
+	}
+
+	// Schedule every group's rounds side by side
+	var rounds []FixtureRound
+	for _, group := range groups {
+		existing, err := uc.matchRepo.FindByGroupID(ctx, group.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return nil, ErrGroupHasMatches
+		}
+
+		groupID := group.ID
+		for r, round := range BuildRoundRobin(group.TeamIDs(), input.DoubleRoundRobin) {
+			if r == len(rounds) {
+				rounds = append(rounds, FixtureRound{Round: r + 1})
+			}
+			for _, pairing := range round {
+				seasonID := input.SeasonID
+				rounds[r].Matches = append(rounds[r].Matches, entity.Match{
+					HomeTeamID: pairing.HomeTeamID,
+					AwayTeamID: pairing.AwayTeamID,
+					SeasonID:   &seasonID,
+					GroupID:    &groupID,
+					Status:     entity.MatchStatusScheduled,
+				})
+			}
+		}
+	}
+
+	var matches []entity.Match
+	dates := matchDates(input.StartDate, input.MatchDays, len(rounds))
+	for i := range rounds {
+		rounds[i].MatchDate = dates[i]
+		for j := range rounds[i].Matches {
+			rounds[i].Matches[j].MatchDate = dates[i]
+			rounds[i].Matches[j].MatchTime = input.KickoffTimes[j%len(input.KickoffTimes)]
+		}
+		matches = append(matches, rounds[i].Matches...)
+	}
+
+	if input.DryRun {
+		// A preview shows the venues and clashes the schedule would have too
+		if err := prepareForSchedule(ctx, uc.scheduler, matches); err != nil {
+			return nil, err
+		}
+	} else {
+		// Every group's matches are created like single new matches, in one
+		// transaction so a failure leaves no group partly scheduled
+		err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			for i := range matches {
+				if err := uc.scheduler.Schedule(ctx, &matches[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Copy the venues and generated IDs back into the rounds
+	k := 0
+	for i := range rounds {
+		for j := range rounds[i].Matches {
+			rounds[i].Matches[j] = matches[k]
+			k++
+		}
+	}
+
+	return rounds, nil
+}
+
+func (uc *groupUseCaseImpl) GenerateKnockout(ctx context.Context, input KnockoutInput) (*entity.Bracket, error) {
+	tables, err := uc.GetTables(ctx, input.CompetitionID, input.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, ErrNoGroups
+	}
+	for _, table := range tables {
+		if !table.Completed {
+			return nil, ErrGroupStageIncomplete
+		}
+	}
+
+	exists, err := uc.bracketRepo.ExistsBySeasonID(ctx, input.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrKnockoutAlreadyGenerated
+	}
+
+	standings := make([][]LeaderboardEntry, len(tables))
+	for i, table := range tables {
+		standings[i] = table.Standings
+	}
+
+	teamIDs, err := SelectQualifiers(standings, input.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	seasonID := input.SeasonID
+	bracket := input.Bracket
+	bracket.SeasonID = &seasonID
+	bracket.TeamIDs = teamIDs
+
+	return uc.bracketUseCase.Create(ctx, bracket)
+}
+
+// findGroup loads a group and checks that it belongs to the season and competition
+func (uc *groupUseCaseImpl) findGroup(ctx context.Context, competitionID, seasonID, groupID uuid.UUID) (*entity.Group, error) {
+	if err := ensureSeasonInCompetition(ctx, uc.seasonRepo, competitionID, seasonID); err != nil {
+		return nil, err
+	}
+
+	group, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrGroupNotFound
+		}
+		return nil, err
+	}
+	if group.SeasonID != seasonID {
+		return nil, ErrGroupNotFound
+	}
+
+	return group, nil
+}
+
+// buildTable computes the standings of a group from its matches
+func (uc *groupUseCaseImpl) buildTable(ctx context.Context, group *entity.Group) (*GroupTable, error) {
+	matches, err := uc.matchRepo.FindByGroupID(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]entity.Team, 0, len(group.Teams))
+	for _, member := range group.Teams {
+		if member.Team != nil {
+			teams = append(teams, *member.Team)
+		}
+	}
+
+	// Cancelled matches will not be played and do not hold up the group
+	completed := len(matches) > 0
+	for _, match := range matches {
+		if match.Status != entity.MatchStatusCompleted && match.Status != entity.MatchStatusCancelled {
+			completed = false
+			break
+		}
+	}
+
+	return &GroupTable{
+		Group:     *group,
+		Standings: ComputeTable(teams, matches, DefaultPointsSystem()),
+		Completed: completed,
+	}, nil
+}
+
+// SelectQualifiers returns the teams that progress from the group stage in
+// seed order: all group winners in group order, then all runners-up, and so
+// on, followed by the best third-placed teams ranked by points, goal
+// difference and goals scored.
+func SelectQualifiers(tables [][]LeaderboardEntry, rules QualificationRules) ([]uuid.UUID, error) {
+	if rules.QualifiersPerGroup < 1 || rules.BestThirdPlaced < 0 {
+		return nil, ErrInvalidQualificationRules
+	}
+
+	var thirds []LeaderboardEntry
+	for _, table := range tables {
+		if len(table) < rules.QualifiersPerGroup {
+			return nil, ErrInvalidQualificationRules
+		}
+		if len(table) > rules.QualifiersPerGroup {
+			thirds = append(thirds, table[rules.QualifiersPerGroup])
+		}
+	}
+	if rules.BestThirdPlaced > len(thirds) {
+		return nil, ErrInvalidQualificationRules
+	}
+
+	var teamIDs []uuid.UUID
+	for position := 0; position < rules.QualifiersPerGroup; position++ {
+		for _, table := range tables {
+			teamIDs = append(teamIDs, table[position].TeamID)
+		}
+	}
+
+	// Stable sort keeps group order between identical records
+	sort.SliceStable(thirds, func(i, j int) bool {
+		return compareRecord(&thirds[i], &thirds[j]) < 0
+	})
+	for _, entry := range thirds[:rules.BestThirdPlaced] {
+		teamIDs = append(teamIDs, entry.TeamID)
+	}
+
+	if len(teamIDs) < 2 {
+		return nil, ErrInvalidQualificationRules
+	}
+
+	return teamIDs, nil
+}
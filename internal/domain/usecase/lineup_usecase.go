@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrLineupNotFound          = errors.New("lineup not found")
+	ErrLineupTeamNotInMatch    = errors.New("lineup team must be the home or away team")
+	ErrLineupPlayerNotInTeam   = errors.New("every lineup player must play for the lineup's team")
+	ErrDuplicateLineupPlayer   = errors.New("a player can only be named once in a lineup")
+	ErrDuplicateJerseyNumber   = errors.New("jersey numbers must be unique within a lineup")
+	ErrInvalidStartingXI       = errors.New("a lineup needs exactly 11 starters")
+	ErrTooManySubstitutes      = errors.New("a lineup can name at most 12 substitutes")
+	ErrInvalidLineupGoalkeeper = errors.New("the goalkeeper must be a registered goalkeeper in the starting XI")
+	ErrInvalidLineupCaptain    = errors.New("the captain must be in the starting XI")
+	ErrInvalidFormation        = errors.New("formation must list the outfield lines, such as 4-4-2, adding up to 10 players")
+	ErrScorerNotInLineup       = errors.New("scorer is not named in the team's lineup")
+)
+
+// LineupUseCase defines the interface for match lineup operations
+type LineupUseCase interface {
+	Save(ctx context.Context, lineup *entity.Lineup) error
+	GetByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Lineup, error)
+	GetByMatchAndTeam(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error)
+	Delete(ctx context.Context, matchID, teamID uuid.UUID) error
+}
+
+type lineupUseCaseImpl struct {
+	lineupRepo   repository.LineupRepository
+	matchRepo    repository.MatchRepository
+	playerRepo   repository.PlayerRepository
+	transferRepo repository.TransferRepository
+	discipline   DisciplineUseCase
+	transactor   repository.Transactor
+}
+
+// NewLineupUseCase creates a new instance of LineupUseCase
+func NewLineupUseCase(
+	lineupRepo repository.LineupRepository,
+	matchRepo repository.MatchRepository,
+	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
+	discipline DisciplineUseCase,
+	transactor repository.Transactor,
+) LineupUseCase {
+	return &lineupUseCaseImpl{
+		lineupRepo:   lineupRepo,
+		matchRepo:    matchRepo,
+		playerRepo:   playerRepo,
+		transferRepo: transferRepo,
+		discipline:   discipline,
+		transactor:   transactor,
+	}
+}
+
+// Save stores the team's lineup for the match, replacing any lineup the team
+// already has
+func (uc *lineupUseCaseImpl) Save(ctx context.Context, lineup *entity.Lineup) error {
+	match, err := uc.matchRepo.FindByID(ctx, lineup.MatchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMatchNotFound
+		}
+		return err
+	}
+	if lineup.TeamID != match.HomeTeamID && lineup.TeamID != match.AwayTeamID {
+		return ErrLineupTeamNotInMatch
+	}
+	if err := validateFormation(lineup.Formation); err != nil {
+		return err
+	}
+	if err := uc.validatePlayers(ctx, match, lineup); err != nil {
+		return err
+	}
+
+	playerIDs := make([]uuid.UUID, len(lineup.Players))
+	for i, named := range lineup.Players {
+		playerIDs[i] = named.PlayerID
+	}
+	if err := uc.discipline.CheckEligibility(ctx, match, playerIDs); err != nil {
+		return err
+	}
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := uc.lineupRepo.FindByMatchAndTeam(ctx, lineup.MatchID, lineup.TeamID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if existing != nil {
+			if err := uc.lineupRepo.Delete(ctx, existing.ID); err != nil {
+				return err
+			}
+		}
+		return uc.lineupRepo.Create(ctx, lineup)
+	})
+}
+
+func (uc *lineupUseCaseImpl) GetByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Lineup, error) {
+	exists, err := uc.matchRepo.Exists(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrMatchNotFound
+	}
+	return uc.lineupRepo.FindByMatchID(ctx, matchID)
+}
+
+func (uc *lineupUseCaseImpl) GetByMatchAndTeam(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error) {
+	lineup, err := uc.lineupRepo.FindByMatchAndTeam(ctx, matchID, teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLineupNotFound
+		}
+		return nil, err
+	}
+	return lineup, nil
+}
+
+func (uc *lineupUseCaseImpl) Delete(ctx context.Context, matchID, teamID uuid.UUID) error {
+	lineup, err := uc.GetByMatchAndTeam(ctx, matchID, teamID)
+	if err != nil {
+		return err
+	}
+	return uc.lineupRepo.Delete(ctx, lineup.ID)
+}
+
+// validatePlayers checks the squad size, the players and their shirt numbers,
+// filling in each player's registered number when none is given
+func (uc *lineupUseCaseImpl) validatePlayers(ctx context.Context, match *entity.Match, lineup *entity.Lineup) error {
+	starters := 0
+	seenPlayers := make(map[uuid.UUID]bool, len(lineup.Players))
+	seenNumbers := make(map[int]bool, len(lineup.Players))
+
+	for i := range lineup.Players {
+		named := &lineup.Players[i]
+		if seenPlayers[named.PlayerID] {
+			return ErrDuplicateLineupPlayer
+		}
+		seenPlayers[named.PlayerID] = true
+
+		player, teamID, err := findPlayerTeamOn(ctx, uc.playerRepo, uc.transferRepo, named.PlayerID, match.MatchDate)
+		if err != nil {
+			return err
+		}
+		if teamID != lineup.TeamID {
+			return ErrLineupPlayerNotInTeam
+		}
+		if named.PlayerID == lineup.GoalkeeperID && player.Position != entity.PositionGoalkeeper {
+			return ErrInvalidLineupGoalkeeper
+		}
+
+		if named.JerseyNumber == 0 {
+			named.JerseyNumber = player.JerseyNumber
+		}
+		if seenNumbers[named.JerseyNumber] {
+			return ErrDuplicateJerseyNumber
+		}
+		seenNumbers[named.JerseyNumber] = true
+
+		if named.IsStarter {
+			starters++
+		}
+	}
+
+	if starters != entity.StartingPlayers {
+		return ErrInvalidStartingXI
+	}
+	if len(lineup.Players)-starters > entity.MaxBenchPlayers {
+		return ErrTooManySubstitutes
+	}
+	if !lineup.IsStarting(lineup.GoalkeeperID) {
+		return ErrInvalidLineupGoalkeeper
+	}
+	if !lineup.IsStarting(lineup.CaptainID) {
+		return ErrInvalidLineupCaptain
+	}
+	return nil
+}
+
+// validateFormation checks an optional formation such as 4-4-2 against the
+// ten outfield starters
+func validateFormation(formation string) error {
+	if formation == "" {
+		return nil
+	}
+
+	lines := strings.Split(formation, "-")
+	if len(lines) < 2 {
+		return ErrInvalidFormation
+	}
+
+	outfield := 0
+	for _, line := range lines {
+		players, err := strconv.Atoi(line)
+		if err != nil || players < 1 {
+			return ErrInvalidFormation
+		}
+		outfield += players
+	}
+	if outfield != entity.StartingPlayers-1 {
+		return ErrInvalidFormation
+	}
+	return nil
+}
+
+// matchLineups holds the lineups of a match by team
+type matchLineups map[uuid.UUID]*entity.Lineup
+
+// loadLineups returns the lineups recorded for a match
+func loadLineups(ctx context.Context, lineupRepo repository.LineupRepository, matchID uuid.UUID) (matchLineups, error) {
+	lineups, err := lineupRepo.FindByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	byTeam := make(matchLineups, len(lineups))
+	for i := range lineups {
+		byTeam[lineups[i].TeamID] = &lineups[i]
+	}
+	return byTeam, nil
+}
+
+// allows checks a player against the team's lineup; teams without a lineup
+// allow any of their players
+func (l matchLineups) allows(teamID, playerID uuid.UUID) bool {
+	lineup, ok := l[teamID]
+	return !ok || lineup.Includes(playerID)
+}
@@ -0,0 +1,367 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMatchNotLive            = errors.New("match is not in progress")
+	ErrInvalidPeriodTransition = errors.New("match cannot move to that period from its current period")
+	ErrGoalNotFound            = errors.New("goal not found")
+	ErrClockStopped            = errors.New("goal minute is required while the match clock is stopped")
+)
+
+// periodTransitions lists the periods a live match may move to from each period
+var periodTransitions = map[entity.MatchPeriod][]entity.MatchPeriod{
+	entity.PeriodFirstHalf:  {entity.PeriodHalfTime},
+	entity.PeriodHalfTime:   {entity.PeriodSecondHalf},
+	entity.PeriodSecondHalf: {entity.PeriodFullTime},
+	entity.PeriodFullTime:   {entity.PeriodExtraTime},
+	entity.PeriodExtraTime:  {entity.PeriodFullTime},
+}
+
+// FinishInput represents the input for finishing a live match
+type FinishInput struct {
+	HomePenalties *int
+	AwayPenalties *int
+}
+
+// LiveMatchUseCase defines the interface for running a match as it is played.
+// Goals are added one at a time and the score is derived from them until the
+// match is finished.
+type LiveMatchUseCase interface {
+	Kickoff(ctx context.Context, matchID uuid.UUID) (*entity.Match, error)
+	StartHalfTime(ctx context.Context, matchID uuid.UUID) (*entity.Match, error)
+	StartSecondHalf(ctx context.Context, matchID uuid.UUID) (*entity.Match, error)
+	EndRegularTime(ctx context.Context, matchID uuid.UUID) (*entity.Match, error)
+	StartExtraTime(ctx context.Context, matchID uuid.UUID) (*entity.Match, error)
+	Finish(ctx context.Context, matchID uuid.UUID, input FinishInput) (*entity.Match, error)
+	AddGoal(ctx context.Context, matchID uuid.UUID, input GoalInput) (*entity.Match, error)
+	RemoveGoal(ctx context.Context, matchID, goalID uuid.UUID) (*entity.Match, error)
+}
+
+type liveMatchUseCaseImpl struct {
+	matchRepo    repository.MatchRepository
+	playerRepo   repository.PlayerRepository
+	transferRepo repository.TransferRepository
+	goalRepo     repository.GoalRepository
+	eventRepo    repository.MatchEventRepository
+	lineupRepo   repository.LineupRepository
+	bracketRepo  repository.BracketRepository
+	discipline   DisciplineUseCase
+	transactor   repository.Transactor
+	feed         MatchFeed
+	observers    []MatchResultObserver
+	now          func() time.Time
+}
+
+// NewLiveMatchUseCase creates a new instance of LiveMatchUseCase. Observers
+// are notified when a live match is finished, as with recorded results.
+func NewLiveMatchUseCase(
+	matchRepo repository.MatchRepository,
+	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
+	goalRepo repository.GoalRepository,
+	eventRepo repository.MatchEventRepository,
+	lineupRepo repository.LineupRepository,
+	bracketRepo repository.BracketRepository,
+	discipline DisciplineUseCase,
+	transactor repository.Transactor,
+	feed MatchFeed,
+	observers ...MatchResultObserver,
+) LiveMatchUseCase {
+	return &liveMatchUseCaseImpl{
+		matchRepo:    matchRepo,
+		playerRepo:   playerRepo,
+		transferRepo: transferRepo,
+		goalRepo:     goalRepo,
+		eventRepo:    eventRepo,
+		lineupRepo:   lineupRepo,
+		bracketRepo:  bracketRepo,
+		discipline:   discipline,
+		transactor:   transactor,
+		feed:         feed,
+		observers:    observers,
+		now:          time.Now,
+	}
+}
+
+func (uc *liveMatchUseCaseImpl) Kickoff(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	match, err := uc.findMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != entity.MatchStatusScheduled {
+		return nil, ErrInvalidPeriodTransition
+	}
+
+	homeScore, awayScore := 0, 0
+	now := uc.now()
+	match.Status = entity.MatchStatusOngoing
+	match.Period = entity.PeriodFirstHalf
+	match.PeriodStartedAt = &now
+	match.HomeScore = &homeScore
+	match.AwayScore = &awayScore
+
+	if err := uc.matchRepo.Update(ctx, match); err != nil {
+		return nil, err
+	}
+	updated, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.StatusChanged(ctx, updated)
+	return updated, nil
+}
+
+func (uc *liveMatchUseCaseImpl) StartHalfTime(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	return uc.moveTo(ctx, matchID, entity.PeriodHalfTime)
+}
+
+func (uc *liveMatchUseCaseImpl) StartSecondHalf(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	return uc.moveTo(ctx, matchID, entity.PeriodSecondHalf)
+}
+
+func (uc *liveMatchUseCaseImpl) EndRegularTime(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	return uc.moveTo(ctx, matchID, entity.PeriodFullTime)
+}
+
+func (uc *liveMatchUseCaseImpl) StartExtraTime(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	return uc.moveTo(ctx, matchID, entity.PeriodExtraTime)
+}
+
+func (uc *liveMatchUseCaseImpl) Finish(ctx context.Context, matchID uuid.UUID, input FinishInput) (*entity.Match, error) {
+	match, err := uc.findLiveMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Period != entity.PeriodFullTime {
+		return nil, ErrInvalidPeriodTransition
+	}
+	// Freeze the result, stored together with everything observers derive
+	// from it as with recorded results
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.applyScore(ctx, match); err != nil {
+			return err
+		}
+		match.HomePenalties = input.HomePenalties
+		match.AwayPenalties = input.AwayPenalties
+		match.Status = entity.MatchStatusCompleted
+		if err := validatePenalties(ctx, uc.bracketRepo, match); err != nil {
+			return err
+		}
+
+		for _, observer := range uc.observers {
+			if err := observer.ValidateResult(ctx, match); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.matchRepo.Update(ctx, match); err != nil {
+			return err
+		}
+
+		for _, observer := range uc.observers {
+			if err := observer.ResultRecorded(ctx, match); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.StatusChanged(ctx, updated)
+	return updated, nil
+}
+
+func (uc *liveMatchUseCaseImpl) AddGoal(ctx context.Context, matchID uuid.UUID, input GoalInput) (*entity.Match, error) {
+	match, err := uc.findLiveMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a minute the goal is timed by the running match clock
+	if input.Minute == 0 {
+		minute, stoppageTime, running := match.Clock(uc.now())
+		if !running {
+			return nil, ErrClockStopped
+		}
+		input.Minute = minute
+		input.StoppageTime = stoppageTime
+	}
+
+	lineups, err := loadLineups(ctx, uc.lineupRepo, matchID)
+	if err != nil {
+		return nil, err
+	}
+	goal, err := newGoal(ctx, uc.playerRepo, uc.transferRepo, match, lineups, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.discipline.CheckEligibility(ctx, match, []uuid.UUID{goal.PlayerID}); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.goalRepo.Create(ctx, goal); err != nil {
+			return err
+		}
+		if err := uc.applyScore(ctx, match); err != nil {
+			return err
+		}
+		return uc.matchRepo.Update(ctx, match)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.GoalScored(ctx, updated, goal)
+	uc.feed.ScoreChanged(ctx, updated)
+	return updated, nil
+}
+
+func (uc *liveMatchUseCaseImpl) RemoveGoal(ctx context.Context, matchID, goalID uuid.UUID) (*entity.Match, error) {
+	match, err := uc.findLiveMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	goal, err := uc.goalRepo.FindByID(ctx, goalID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrGoalNotFound
+		}
+		return nil, err
+	}
+	if goal.MatchID != matchID {
+		return nil, ErrGoalNotFound
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.goalRepo.Delete(ctx, goalID); err != nil {
+			return err
+		}
+		// The goal's assist goes with it
+		if err := uc.eventRepo.DeleteOrphanedAssists(ctx, matchID); err != nil {
+			return err
+		}
+		if err := uc.applyScore(ctx, match); err != nil {
+			return err
+		}
+		return uc.matchRepo.Update(ctx, match)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.GoalRemoved(ctx, updated, goal)
+	uc.feed.ScoreChanged(ctx, updated)
+	return updated, nil
+}
+
+// moveTo advances a live match to the given period, restarting the clock
+// when play resumes
+func (uc *liveMatchUseCaseImpl) moveTo(ctx context.Context, matchID uuid.UUID, period entity.MatchPeriod) (*entity.Match, error) {
+	match, err := uc.findLiveMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := false
+	for _, next := range periodTransitions[match.Period] {
+		if next == period {
+			allowed = true
+			break
+		}
+	}
+	// Extra time is played at most once
+	if period == entity.PeriodExtraTime && match.ExtraTime {
+		allowed = false
+	}
+	if !allowed {
+		return nil, ErrInvalidPeriodTransition
+	}
+
+	match.Period = period
+	match.PeriodStartedAt = nil
+	switch period {
+	case entity.PeriodSecondHalf, entity.PeriodExtraTime:
+		now := uc.now()
+		match.PeriodStartedAt = &now
+	}
+	if period == entity.PeriodExtraTime {
+		match.ExtraTime = true
+	}
+
+	if err := uc.matchRepo.Update(ctx, match); err != nil {
+		return nil, err
+	}
+	updated, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.StatusChanged(ctx, updated)
+	return updated, nil
+}
+
+// applyScore derives the match score from its stored goals
+func (uc *liveMatchUseCaseImpl) applyScore(ctx context.Context, match *entity.Match) error {
+	goals, err := uc.goalRepo.FindByMatchID(ctx, match.ID)
+	if err != nil {
+		return err
+	}
+
+	homeScore, awayScore := countGoals(match, goals)
+	match.HomeScore = &homeScore
+	match.AwayScore = &awayScore
+	return nil
+}
+
+// findLiveMatch returns the match when it is in progress
+func (uc *liveMatchUseCaseImpl) findLiveMatch(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	match, err := uc.findMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != entity.MatchStatusOngoing {
+		return nil, ErrMatchNotLive
+	}
+	return match, nil
+}
+
+func (uc *liveMatchUseCaseImpl) findMatch(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	match, err := uc.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, err
+	}
+	return match, nil
+}
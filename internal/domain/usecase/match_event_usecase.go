@@ -0,0 +1,381 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMatchEventNotFound    = errors.New("match event not found")
+	ErrInvalidMatchEventType = errors.New("invalid match event type")
+	ErrMatchNotStarted       = errors.New("events can only be recorded for ongoing or completed matches")
+	ErrEventTeamNotInMatch   = errors.New("event team must be the home or away team")
+	ErrPlayerNotInTeam       = errors.New("player does not play for the event's team")
+	ErrInvalidSubstitution   = errors.New("a substitution needs a different player from the same team coming on")
+	ErrInvalidAssist         = errors.New("an assist must reference a goal of this match scored by a teammate")
+	ErrAssistAlreadyRecorded = errors.New("goal already has an assist")
+	ErrInvalidCardSequence   = errors.New("card does not follow the player's earlier cards")
+	ErrPlayerSentOff         = errors.New("player has already been sent off")
+	ErrEventMinuteRequired   = errors.New("minute is required for this event type")
+)
+
+// MatchEventUseCase defines the interface for match event operations.
+// It observes match results so assists never outlive their goals.
+type MatchEventUseCase interface {
+	MatchResultObserver
+	Create(ctx context.Context, event *entity.MatchEvent) error
+	Update(ctx context.Context, event *entity.MatchEvent) error
+	Delete(ctx context.Context, matchID, eventID uuid.UUID) error
+	GetByID(ctx context.Context, matchID, eventID uuid.UUID) (*entity.MatchEvent, error)
+	GetByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchEvent, error)
+}
+
+type matchEventUseCaseImpl struct {
+	eventRepo    repository.MatchEventRepository
+	matchRepo    repository.MatchRepository
+	playerRepo   repository.PlayerRepository
+	transferRepo repository.TransferRepository
+	goalRepo     repository.GoalRepository
+	transactor   repository.Transactor
+	discipline   DisciplineUseCase
+}
+
+// NewMatchEventUseCase creates a new instance of MatchEventUseCase. Card
+// changes are applied to the suspension ledger in the same transaction.
+func NewMatchEventUseCase(
+	eventRepo repository.MatchEventRepository,
+	matchRepo repository.MatchRepository,
+	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
+	goalRepo repository.GoalRepository,
+	transactor repository.Transactor,
+	discipline DisciplineUseCase,
+) MatchEventUseCase {
+	return &matchEventUseCaseImpl{
+		eventRepo:    eventRepo,
+		matchRepo:    matchRepo,
+		playerRepo:   playerRepo,
+		transferRepo: transferRepo,
+		goalRepo:     goalRepo,
+		transactor:   transactor,
+		discipline:   discipline,
+	}
+}
+
+func (uc *matchEventUseCaseImpl) Create(ctx context.Context, event *entity.MatchEvent) error {
+	if err := uc.validate(ctx, event); err != nil {
+		return err
+	}
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.eventRepo.Create(ctx, event); err != nil {
+			return err
+		}
+		return uc.syncCards(ctx, event)
+	})
+}
+
+func (uc *matchEventUseCaseImpl) Update(ctx context.Context, event *entity.MatchEvent) error {
+	existing, err := uc.GetByID(ctx, event.MatchID, event.ID)
+	if err != nil {
+		return err
+	}
+	event.CreatedAt = existing.CreatedAt
+
+	// A first yellow cannot stop being one while the second yellow it led to
+	// remains
+	if existing.Type == entity.MatchEventYellowCard && (event.Type != entity.MatchEventYellowCard || event.PlayerID != existing.PlayerID) {
+		if err := uc.ensureNoSecondYellow(ctx, existing); err != nil {
+			return err
+		}
+	}
+	if err := uc.validate(ctx, event); err != nil {
+		return err
+	}
+	// The cards left must still follow on from each other
+	if existing.IsCard() || event.IsCard() {
+		if err := uc.checkCardSequence(ctx, existing, event); err != nil {
+			return err
+		}
+	}
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.eventRepo.Update(ctx, event); err != nil {
+			return err
+		}
+		return uc.syncCards(ctx, existing, event)
+	})
+}
+
+func (uc *matchEventUseCaseImpl) Delete(ctx context.Context, matchID, eventID uuid.UUID) error {
+	event, err := uc.GetByID(ctx, matchID, eventID)
+	if err != nil {
+		return err
+	}
+
+	// A first yellow cannot go while the second yellow it led to remains
+	if event.Type == entity.MatchEventYellowCard {
+		if err := uc.ensureNoSecondYellow(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.eventRepo.Delete(ctx, eventID); err != nil {
+			return err
+		}
+		return uc.syncCards(ctx, event)
+	})
+}
+
+func (uc *matchEventUseCaseImpl) GetByID(ctx context.Context, matchID, eventID uuid.UUID) (*entity.MatchEvent, error) {
+	event, err := uc.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMatchEventNotFound
+		}
+		return nil, err
+	}
+	if event.MatchID != matchID {
+		return nil, ErrMatchEventNotFound
+	}
+	return event, nil
+}
+
+func (uc *matchEventUseCaseImpl) GetByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchEvent, error) {
+	exists, err := uc.matchRepo.Exists(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrMatchNotFound
+	}
+	return uc.eventRepo.FindByMatchID(ctx, matchID)
+}
+
+// ValidateResult implements MatchResultObserver; events never block a result
+func (uc *matchEventUseCaseImpl) ValidateResult(ctx context.Context, match *entity.Match) error {
+	return nil
+}
+
+// ResultRecorded implements MatchResultObserver. Recording a result replaces
+// the match's goals, so assists linked to the old goals are dropped.
+func (uc *matchEventUseCaseImpl) ResultRecorded(ctx context.Context, match *entity.Match) error {
+	return uc.eventRepo.DeleteOrphanedAssists(ctx, match.ID)
+}
+
+// validate checks the event against its match, its players and the events
+// already recorded for the player
+func (uc *matchEventUseCaseImpl) validate(ctx context.Context, event *entity.MatchEvent) error {
+	if !entity.IsValidMatchEventType(event.Type) {
+		return ErrInvalidMatchEventType
+	}
+	// Assists take their time from the goal they set up
+	if event.Type != entity.MatchEventAssist && event.Minute < 1 {
+		return ErrEventMinuteRequired
+	}
+
+	match, err := uc.matchRepo.FindByID(ctx, event.MatchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMatchNotFound
+		}
+		return err
+	}
+	if match.Status != entity.MatchStatusOngoing && match.Status != entity.MatchStatusCompleted {
+		return ErrMatchNotStarted
+	}
+	if event.TeamID != match.HomeTeamID && event.TeamID != match.AwayTeamID {
+		return ErrEventTeamNotInMatch
+	}
+	if err := uc.ensurePlayerInTeam(ctx, match, event.PlayerID, event.TeamID); err != nil {
+		return err
+	}
+
+	// Links only apply to the event types that use them
+	if event.Type != entity.MatchEventSubstitution {
+		event.PlayerInID = nil
+	}
+	if event.Type != entity.MatchEventAssist {
+		event.GoalID = nil
+	}
+
+	switch event.Type {
+	case entity.MatchEventSubstitution:
+		if event.PlayerInID == nil || *event.PlayerInID == event.PlayerID {
+			return ErrInvalidSubstitution
+		}
+		if err := uc.ensurePlayerInTeam(ctx, match, *event.PlayerInID, event.TeamID); err != nil {
+			if errors.Is(err, ErrPlayerNotInTeam) {
+				return ErrInvalidSubstitution
+			}
+			return err
+		}
+	case entity.MatchEventAssist:
+		return uc.validateAssist(ctx, event)
+	case entity.MatchEventYellowCard, entity.MatchEventSecondYellow, entity.MatchEventRedCard:
+		return uc.validateCard(ctx, event)
+	}
+
+	return nil
+}
+
+// validateAssist checks the linked goal and takes the assist's time from it
+func (uc *matchEventUseCaseImpl) validateAssist(ctx context.Context, event *entity.MatchEvent) error {
+	if event.GoalID == nil {
+		return ErrInvalidAssist
+	}
+
+	goal, err := uc.goalRepo.FindByID(ctx, *event.GoalID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidAssist
+		}
+		return err
+	}
+	if goal.MatchID != event.MatchID || goal.IsOwnGoal || goal.TeamID != event.TeamID || goal.PlayerID == event.PlayerID {
+		return ErrInvalidAssist
+	}
+
+	existing, err := uc.eventRepo.FindAssistByGoalID(ctx, goal.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if existing != nil && existing.ID != event.ID {
+		return ErrAssistAlreadyRecorded
+	}
+
+	event.Minute = goal.Minute
+	event.StoppageTime = goal.StoppageTime
+	return nil
+}
+
+// validateCard checks a card against the cards the player already received
+func (uc *matchEventUseCaseImpl) validateCard(ctx context.Context, event *entity.MatchEvent) error {
+	events, err := uc.eventRepo.FindByMatchAndPlayer(ctx, event.MatchID, event.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	hasYellow := false
+	for _, other := range events {
+		if other.ID == event.ID || other.PlayerID != event.PlayerID {
+			continue
+		}
+		if other.IsSendingOff() {
+			return ErrPlayerSentOff
+		}
+		if other.Type == entity.MatchEventYellowCard {
+			hasYellow = true
+		}
+	}
+
+	switch event.Type {
+	case entity.MatchEventYellowCard:
+		// A player's second yellow is recorded as second_yellow
+		if hasYellow {
+			return ErrInvalidCardSequence
+		}
+	case entity.MatchEventSecondYellow:
+		if !hasYellow {
+			return ErrInvalidCardSequence
+		}
+	}
+
+	return nil
+}
+
+// ensureNoSecondYellow rejects a change to a first yellow card while the
+// player's second yellow of the match depends on it
+func (uc *matchEventUseCaseImpl) ensureNoSecondYellow(ctx context.Context, yellow *entity.MatchEvent) error {
+	events, err := uc.eventRepo.FindByMatchAndPlayer(ctx, yellow.MatchID, yellow.PlayerID)
+	if err != nil {
+		return err
+	}
+	for _, other := range events {
+		if other.Type == entity.MatchEventSecondYellow {
+			return ErrInvalidCardSequence
+		}
+	}
+	return nil
+}
+
+// checkCardSequence checks the cards of the players an update touches, with
+// the updated event in place of the stored one. A player has at most one
+// first yellow and one sending off, and a second yellow needs a first yellow.
+func (uc *matchEventUseCaseImpl) checkCardSequence(ctx context.Context, existing, updated *entity.MatchEvent) error {
+	playerIDs := []uuid.UUID{existing.PlayerID}
+	if updated.PlayerID != existing.PlayerID {
+		playerIDs = append(playerIDs, updated.PlayerID)
+	}
+
+	for _, playerID := range playerIDs {
+		events, err := uc.eventRepo.FindByMatchAndPlayer(ctx, updated.MatchID, playerID)
+		if err != nil {
+			return err
+		}
+
+		cards := []*entity.MatchEvent{updated}
+		for i := range events {
+			if events[i].ID != existing.ID {
+				cards = append(cards, &events[i])
+			}
+		}
+
+		yellows, secondYellows, sendingsOff := 0, 0, 0
+		for _, card := range cards {
+			if card.PlayerID != playerID || !card.IsCard() {
+				continue
+			}
+			switch card.Type {
+			case entity.MatchEventYellowCard:
+				yellows++
+			case entity.MatchEventSecondYellow:
+				secondYellows++
+				sendingsOff++
+			default:
+				sendingsOff++
+			}
+		}
+
+		if sendingsOff > 1 {
+			return ErrPlayerSentOff
+		}
+		if yellows > 1 || secondYellows > yellows {
+			return ErrInvalidCardSequence
+		}
+	}
+	return nil
+}
+
+// syncCards rebuilds the suspensions of the players whose cards changed
+func (uc *matchEventUseCaseImpl) syncCards(ctx context.Context, events ...*entity.MatchEvent) error {
+	synced := make(map[uuid.UUID]bool)
+	for _, event := range events {
+		if !event.IsCard() || synced[event.PlayerID] {
+			continue
+		}
+		if err := uc.discipline.SyncPlayer(ctx, event.PlayerID); err != nil {
+			return err
+		}
+		synced[event.PlayerID] = true
+	}
+	return nil
+}
+
+// ensurePlayerInTeam checks that the player exists and played for the team
+// on the match day
+func (uc *matchEventUseCaseImpl) ensurePlayerInTeam(ctx context.Context, match *entity.Match, playerID, teamID uuid.UUID) error {
+	_, playedFor, err := findPlayerTeamOn(ctx, uc.playerRepo, uc.transferRepo, playerID, match.MatchDate)
+	if err != nil {
+		return err
+	}
+	if playedFor != teamID {
+		return ErrPlayerNotInTeam
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+	"gorm.io/gorm"
+)
+
+// eventLog is a MatchEventRepository holding the events of a test
+type eventLog struct {
+	repository.MatchEventRepository
+	events []entity.MatchEvent
+}
+
+func (l *eventLog) add(event entity.MatchEvent) entity.MatchEvent {
+	event.ID = uuid.New()
+	l.events = append(l.events, event)
+	return event
+}
+
+func (l *eventLog) FindByID(ctx context.Context, id uuid.UUID) (*entity.MatchEvent, error) {
+	for _, event := range l.events {
+		if event.ID == id {
+			return &event, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (l *eventLog) FindByMatchAndPlayer(ctx context.Context, matchID, playerID uuid.UUID) ([]entity.MatchEvent, error) {
+	var events []entity.MatchEvent
+	for _, event := range l.events {
+		if event.MatchID == matchID && (event.PlayerID == playerID || (event.PlayerInID != nil && *event.PlayerInID == playerID)) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func TestMatchEventUseCaseUpdate(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness()
+	persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+	persib := h.store.AddTeam("Persib Bandung", "Bandung")
+	simic := h.store.AddPlayer(persija, "Marko Simic", 9)
+	riko := h.store.AddPlayer(persija, "Riko Simanjuntak", 25)
+	hanif := h.store.AddPlayer(persija, "Hanif Sjahbandi", 19)
+	match := h.store.AddMatch(persija, persib, kickoff(10), memory.Completed(1, 0))
+
+	log := &eventLog{}
+	card := func(player *entity.Player, eventType entity.MatchEventType, minute int) entity.MatchEvent {
+		return log.add(entity.MatchEvent{MatchID: match.ID, TeamID: player.TeamID, PlayerID: player.ID, Type: eventType, Minute: minute})
+	}
+	yellow := card(simic, entity.MatchEventYellowCard, 20)
+	card(simic, entity.MatchEventSecondYellow, 60)
+	rikoYellow := card(riko, entity.MatchEventYellowCard, 30)
+	rikoRed := card(riko, entity.MatchEventRedCard, 70)
+
+	events := usecase.NewMatchEventUseCase(log, h.matches, h.players, h.transfers, h.goals, h.transactor, noSuspensions{})
+	update := func(event entity.MatchEvent, change func(*entity.MatchEvent)) error {
+		change(&event)
+		return events.Update(ctx, &event)
+	}
+
+	cases := []struct {
+		name   string
+		event  entity.MatchEvent
+		change func(*entity.MatchEvent)
+		want   error
+	}{
+		{"first yellow of a second yellow made a red", yellow, func(e *entity.MatchEvent) { e.Type = entity.MatchEventRedCard }, usecase.ErrInvalidCardSequence},
+		{"first yellow of a second yellow moved to a teammate", yellow, func(e *entity.MatchEvent) { e.PlayerID = hanif.ID }, usecase.ErrInvalidCardSequence},
+		{"yellow made a second red", rikoYellow, func(e *entity.MatchEvent) { e.Type = entity.MatchEventRedCard }, usecase.ErrPlayerSentOff},
+		{"red moved to a booked player", rikoRed, func(e *entity.MatchEvent) { e.PlayerID = simic.ID }, usecase.ErrPlayerSentOff},
+	}
+	for _, tc := range cases {
+		if err := update(tc.event, tc.change); !errors.Is(err, tc.want) {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+// allMatchesTopic receives the updates of every match
+const allMatchesTopic = "matches"
+
+// MatchFeedEventType represents the kind of live match update
+type MatchFeedEventType string
+
+const (
+	FeedScoreChanged  MatchFeedEventType = "score_changed"
+	FeedStatusChanged MatchFeedEventType = "status_changed"
+	FeedGoalScored    MatchFeedEventType = "goal"
+	FeedGoalRemoved   MatchFeedEventType = "goal_removed"
+)
+
+// MatchFeedEvent represents a live update about a match
+type MatchFeedEvent struct {
+	Type       MatchFeedEventType `json:"type"`
+	MatchID    uuid.UUID          `json:"match_id"`
+	Status     entity.MatchStatus `json:"status"`
+	Period     entity.MatchPeriod `json:"period,omitempty"`
+	HomeTeamID uuid.UUID          `json:"home_team_id"`
+	AwayTeamID uuid.UUID          `json:"away_team_id"`
+	HomeScore  *int               `json:"home_score"`
+	AwayScore  *int               `json:"away_score"`
+	Goal       *MatchFeedGoal     `json:"goal,omitempty"`
+	OccurredAt time.Time          `json:"occurred_at"`
+}
+
+// MatchFeedGoal represents the goal a feed event is about
+type MatchFeedGoal struct {
+	ID           uuid.UUID `json:"id"`
+	PlayerID     uuid.UUID `json:"player_id"`
+	TeamID       uuid.UUID `json:"team_id"`
+	Minute       int       `json:"minute"`
+	StoppageTime int       `json:"stoppage_time"`
+	IsOwnGoal    bool      `json:"is_own_goal"`
+	IsPenalty    bool      `json:"is_penalty"`
+}
+
+// MatchFeed publishes live match updates and lets clients follow them.
+// Publishing is best effort: a failure is logged and never fails the change
+// that triggered it.
+type MatchFeed interface {
+	ScoreChanged(ctx context.Context, match *entity.Match)
+	StatusChanged(ctx context.Context, match *entity.Match)
+	GoalScored(ctx context.Context, match *entity.Match, goal *entity.Goal)
+	GoalRemoved(ctx context.Context, match *entity.Match, goal *entity.Goal)
+	// Subscribe follows a single match, or every match when matchID is nil,
+	// until ctx is done
+	Subscribe(ctx context.Context, matchID *uuid.UUID) (<-chan MatchFeedEvent, error)
+}
+
+type matchFeedImpl struct {
+	publisher  repository.Publisher
+	subscriber repository.Subscriber
+}
+
+// NewMatchFeed creates a new instance of MatchFeed on top of a message broker
+func NewMatchFeed(publisher repository.Publisher, subscriber repository.Subscriber) MatchFeed {
+	return &matchFeedImpl{publisher: publisher, subscriber: subscriber}
+}
+
+func (f *matchFeedImpl) ScoreChanged(ctx context.Context, match *entity.Match) {
+	f.publish(ctx, FeedScoreChanged, match, nil)
+}
+
+func (f *matchFeedImpl) StatusChanged(ctx context.Context, match *entity.Match) {
+	f.publish(ctx, FeedStatusChanged, match, nil)
+}
+
+func (f *matchFeedImpl) GoalScored(ctx context.Context, match *entity.Match, goal *entity.Goal) {
+	f.publish(ctx, FeedGoalScored, match, goal)
+}
+
+func (f *matchFeedImpl) GoalRemoved(ctx context.Context, match *entity.Match, goal *entity.Goal) {
+	f.publish(ctx, FeedGoalRemoved, match, goal)
+}
+
+func (f *matchFeedImpl) Subscribe(ctx context.Context, matchID *uuid.UUID) (<-chan MatchFeedEvent, error) {
+	topic := allMatchesTopic
+	if matchID != nil {
+		topic = matchTopic(*matchID)
+	}
+
+	messages, err := f.subscriber.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan MatchFeedEvent)
+	go func() {
+		defer close(events)
+		for msg := range messages {
+			var event MatchFeedEvent
+			if err := json.Unmarshal(msg.Data, &event); err != nil {
+				log.Printf("Warning: Failed to decode match feed event: %v", err)
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// publish sends the event to the match's own topic and the all-matches topic
+func (f *matchFeedImpl) publish(ctx context.Context, eventType MatchFeedEventType, match *entity.Match, goal *entity.Goal) {
+	event := MatchFeedEvent{
+		Type:       eventType,
+		MatchID:    match.ID,
+		Status:     match.Status,
+		Period:     match.Period,
+		HomeTeamID: match.HomeTeamID,
+		AwayTeamID: match.AwayTeamID,
+		HomeScore:  match.HomeScore,
+		AwayScore:  match.AwayScore,
+		OccurredAt: time.Now().UTC(),
+	}
+	if goal != nil {
+		event.Goal = &MatchFeedGoal{
+			ID:           goal.ID,
+			PlayerID:     goal.PlayerID,
+			TeamID:       goal.TeamID,
+			Minute:       goal.Minute,
+			StoppageTime: goal.StoppageTime,
+			IsOwnGoal:    goal.IsOwnGoal,
+			IsPenalty:    goal.IsPenalty,
+		}
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Warning: Failed to encode match feed event: %v", err)
+		return
+	}
+
+	for _, topic := range []string{matchTopic(match.ID), allMatchesTopic} {
+		if err := f.publisher.Publish(ctx, topic, data); err != nil {
+			log.Printf("Warning: Failed to publish match feed event: %v", err)
+		}
+	}
+}
+
+// matchTopic returns the topic of a single match
+func matchTopic(matchID uuid.UUID) string {
+	return allMatchesTopic + "." + matchID.String()
+}
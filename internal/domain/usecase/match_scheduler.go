@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+// MatchScheduler places new matches at a venue and keeps them within the
+// scheduling rules, for every feature that creates matches
+type MatchScheduler interface {
+	// PrepareForSchedule defaults the match's venue to the home team's home
+	// ground and rejects a match that clashes with a stored match or with one
+	// of the pending matches, which are about to be stored with it
+	PrepareForSchedule(ctx context.Context, match *entity.Match, pending ...entity.Match) error
+	// Schedule prepares a new match and stores it
+	Schedule(ctx context.Context, match *entity.Match) error
+}
+
+type matchScheduler struct {
+	matchRepo  repository.MatchRepository
+	teamRepo   repository.TeamRepository
+	venueRepo  repository.VenueRepository
+	scheduling SchedulingRules
+}
+
+// NewMatchScheduler creates a new instance of MatchScheduler
+func NewMatchScheduler(
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	venueRepo repository.VenueRepository,
+	scheduling SchedulingRules,
+) MatchScheduler {
+	return newMatchScheduler(matchRepo, teamRepo, venueRepo, scheduling)
+}
+
+func newMatchScheduler(
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	venueRepo repository.VenueRepository,
+	scheduling SchedulingRules,
+) *matchScheduler {
+	return &matchScheduler{
+		matchRepo:  matchRepo,
+		teamRepo:   teamRepo,
+		venueRepo:  venueRepo,
+		scheduling: scheduling,
+	}
+}
+
+func (s *matchScheduler) PrepareForSchedule(ctx context.Context, match *entity.Match, pending ...entity.Match) error {
+	if err := s.assignVenue(ctx, match); err != nil {
+		return err
+	}
+	return s.checkSchedule(ctx, match, pending...)
+}
+
+func (s *matchScheduler) Schedule(ctx context.Context, match *entity.Match) error {
+	if err := s.PrepareForSchedule(ctx, match); err != nil {
+		return err
+	}
+	return s.matchRepo.Create(ctx, match)
+}
+
+// assignVenue checks the match's venue, defaulting it to the home team's
+// home ground
+func (s *matchScheduler) assignVenue(ctx context.Context, match *entity.Match) error {
+	if match.VenueID != nil {
+		return validateVenue(ctx, s.venueRepo, match.VenueID)
+	}
+	home, err := s.teamRepo.FindByID(ctx, match.HomeTeamID)
+	if err != nil {
+		return err
+	}
+	match.VenueID = home.HomeVenueID
+	return nil
+}
+
+// checkSchedule rejects a match that is booked at its venue within the venue
+// slot of another match, or that gives either team less than the rest window
+// between kickoffs. Cancelled matches neither clash nor are checked.
+func (s *matchScheduler) checkSchedule(ctx context.Context, match *entity.Match, pending ...entity.Match) error {
+	if match.Status == entity.MatchStatusCancelled {
+		return nil
+	}
+	kickoff, ok := match.KickoffAt()
+	if !ok {
+		return ErrInvalidMatchTime
+	}
+
+	window := max(s.scheduling.VenueSlot, s.scheduling.RestWindow)
+	if window == 0 {
+		return nil
+	}
+
+	// Matches are stored by date, so every day the window touches is loaded
+	// and the exact kickoffs compared
+	from, to := kickoff.Add(-window), kickoff.Add(window)
+	stored, err := s.matchRepo.FindForScheduling(ctx,
+		time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location()),
+		time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, to.Location()),
+		[]uuid.UUID{match.HomeTeamID, match.AwayTeamID},
+		match.VenueID,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range append(stored, pending...) {
+		// Pending matches have no ID until they are stored
+		if (match.ID != uuid.Nil && other.ID == match.ID) || other.Status == entity.MatchStatusCancelled {
+			continue
+		}
+		otherKickoff, ok := other.KickoffAt()
+		if !ok {
+			continue
+		}
+		gap := kickoff.Sub(otherKickoff).Abs()
+
+		sameVenue := match.VenueID != nil && other.VenueID != nil && *match.VenueID == *other.VenueID
+		if sameVenue && gap < s.scheduling.VenueSlot {
+			return fmt.Errorf("%w: %s kicks off %s", ErrVenueDoubleBooked, describeMatch(&other), otherKickoff.Format("2006-01-02 15:04"))
+		}
+
+		for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+			if (teamID == other.HomeTeamID || teamID == other.AwayTeamID) && gap < s.scheduling.RestWindow {
+				return fmt.Errorf("%w: team %s plays %s at %s", ErrTeamNotRested, teamID, describeMatch(&other), otherKickoff.Format("2006-01-02 15:04"))
+			}
+		}
+	}
+	return nil
+}
+
+// describeMatch names a match in a clash error. Pending matches have no ID
+// until they are stored.
+func describeMatch(match *entity.Match) string {
+	if match.ID == uuid.Nil {
+		return fmt.Sprintf("the new match between %s and %s", match.HomeTeamID, match.AwayTeamID)
+	}
+	return "match " + match.ID.String()
+}
@@ -0,0 +1,42 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestMatchSchedulerPrepareForSchedule(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness()
+	persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+	persib := h.store.AddTeam("Persib Bandung", "Bandung")
+	arema := h.store.AddTeam("Arema FC", "Malang")
+	bali := h.store.AddTeam("Bali United", "Gianyar")
+	h.store.AddMatch(persija, persib, kickoff(10))
+
+	scheduler := usecase.NewMatchScheduler(h.matches, h.teams, nil, usecase.SchedulingRules{RestWindow: 48 * time.Hour})
+	newMatch := func(home, away *entity.Team, day int) entity.Match {
+		return entity.Match{MatchDate: kickoff(day).Truncate(24 * time.Hour), MatchTime: "15:00", HomeTeamID: home.ID, AwayTeamID: away.ID, Status: entity.MatchStatusScheduled}
+	}
+
+	stored := newMatch(arema, persija, 11)
+	if err := scheduler.PrepareForSchedule(ctx, &stored); !errors.Is(err, usecase.ErrTeamNotRested) {
+		t.Fatalf("got error %v, want %v against the stored match", err, usecase.ErrTeamNotRested)
+	}
+
+	pending := []entity.Match{newMatch(arema, bali, 20)}
+	next := newMatch(bali, persib, 21)
+	if err := scheduler.PrepareForSchedule(ctx, &next, pending...); !errors.Is(err, usecase.ErrTeamNotRested) {
+		t.Fatalf("got error %v, want %v against the pending match", err, usecase.ErrTeamNotRested)
+	}
+
+	later := newMatch(bali, persib, 23)
+	if err := scheduler.PrepareForSchedule(ctx, &later, pending...); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -12,26 +12,65 @@ import (
 )
 
 var (
-	ErrMatchNotFound       = errors.New("match not found")
-	ErrSameTeamMatch       = errors.New("home team and away team cannot be the same")
-	ErrMatchAlreadyPlayed  = errors.New("match has already been played")
-	ErrMatchNotCompleted   = errors.New("match has not been completed yet")
-	ErrInvalidMatchStatus  = errors.New("invalid match status")
+	ErrMatchNotFound        = errors.New("match not found")
+	ErrSameTeamMatch        = errors.New("home team and away team cannot be the same")
+	ErrMatchAlreadyPlayed   = errors.New("match has already been played")
+	ErrMatchNotCompleted    = errors.New("match has not been completed yet")
+	ErrMatchInProgress      = errors.New("match is in progress; end it with POST /matches/:id/finish")
+	ErrInvalidMatchStatus   = errors.New("invalid match status")
+	ErrInvalidPenalties     = errors.New("a penalty shootout needs a score for both teams and a winner")
+	ErrGoalTeamNotInMatch   = errors.New("goal team must be the home or away team")
+	ErrScorerNotInTeam      = errors.New("scorer does not play for the goal's team")
+	ErrGoalsDoNotMatchScore = errors.New("goals per team must add up to the final score")
+	ErrPenaltyOwnGoal       = errors.New("an own goal cannot be a penalty")
+	ErrInvalidMatchTime     = errors.New("match time must be in HH:MM format")
+	ErrVenueDoubleBooked    = errors.New("venue is already booked for another match at that time")
+	ErrTeamNotRested        = errors.New("team plays another match within the rest window")
+
+	ErrInvalidSchedulingRules = errors.New("venue slot and rest window cannot be negative")
 )
 
+// SchedulingRules defines how far apart matches must be kept
+type SchedulingRules struct {
+	VenueSlot  time.Duration // How long a match occupies its venue, 0 disables the check
+	RestWindow time.Duration // Least time between a team's kickoffs, 0 disables the check
+}
+
+// Validate checks the scheduling rules
+func (r SchedulingRules) Validate() error {
+	if r.VenueSlot < 0 || r.RestWindow < 0 {
+		return ErrInvalidSchedulingRules
+	}
+	return nil
+}
+
 // MatchResultInput represents the input for recording a match result
 type MatchResultInput struct {
-	HomeScore int
-	AwayScore int
-	Goals     []GoalInput
+	HomeScore     int
+	AwayScore     int
+	ExtraTime     bool
+	HomePenalties *int
+	AwayPenalties *int
+	Goals         []GoalInput
+}
+
+// MatchResultObserver is notified when a match result is recorded, letting
+// other features react to results without the match use case knowing them
+type MatchResultObserver interface {
+	// ValidateResult is called before the result is stored and may reject it
+	ValidateResult(ctx context.Context, match *entity.Match) error
+	// ResultRecorded is called after the result and its goals are stored
+	ResultRecorded(ctx context.Context, match *entity.Match) error
 }
 
 // GoalInput represents a goal input
 type GoalInput struct {
-	PlayerID  uuid.UUID
-	TeamID    uuid.UUID
-	Minute    int
-	IsOwnGoal bool
+	PlayerID     uuid.UUID
+	TeamID       uuid.UUID
+	Minute       int
+	StoppageTime int
+	IsOwnGoal    bool
+	IsPenalty    bool
 }
 
 // MatchUseCase defines the interface for match operations
@@ -45,29 +84,62 @@ type MatchUseCase interface {
 	GetByDateRange(ctx context.Context, startDate, endDate time.Time, page, limit int) ([]entity.Match, int64, error)
 	GetByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error)
 	GetByStatus(ctx context.Context, status entity.MatchStatus, page, limit int) ([]entity.Match, int64, error)
+	GetBySeasonID(ctx context.Context, seasonID uuid.UUID, page, limit int) ([]entity.Match, int64, error)
 	RecordResult(ctx context.Context, matchID uuid.UUID, input MatchResultInput) (*entity.Match, error)
 	GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error)
 }
 
 type matchUseCaseImpl struct {
-	matchRepo  repository.MatchRepository
-	teamRepo   repository.TeamRepository
-	playerRepo repository.PlayerRepository
-	goalRepo   repository.GoalRepository
+	matchRepo    repository.MatchRepository
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	transferRepo repository.TransferRepository
+	goalRepo     repository.GoalRepository
+	seasonRepo   repository.SeasonRepository
+	bracketRepo  repository.BracketRepository
+	lineupRepo   repository.LineupRepository
+	discipline   DisciplineUseCase
+	transactor   repository.Transactor
+	feed         MatchFeed
+	scheduler    *matchScheduler
+	ratings      RatingUseCase
+	observers    []MatchResultObserver
 }
 
-// NewMatchUseCase creates a new instance of MatchUseCase
+// NewMatchUseCase creates a new instance of MatchUseCase. Ratings are rebuilt
+// when an edit or deletion changes a completed match.
 func NewMatchUseCase(
 	matchRepo repository.MatchRepository,
 	teamRepo repository.TeamRepository,
 	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
 	goalRepo repository.GoalRepository,
+	seasonRepo repository.SeasonRepository,
+	bracketRepo repository.BracketRepository,
+	lineupRepo repository.LineupRepository,
+	venueRepo repository.VenueRepository,
+	discipline DisciplineUseCase,
+	transactor repository.Transactor,
+	feed MatchFeed,
+	scheduling SchedulingRules,
+	ratings RatingUseCase,
+	observers ...MatchResultObserver,
 ) MatchUseCase {
 	return &matchUseCaseImpl{
-		matchRepo:  matchRepo,
-		teamRepo:   teamRepo,
-		playerRepo: playerRepo,
-		goalRepo:   goalRepo,
+		matchRepo:    matchRepo,
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		transferRepo: transferRepo,
+		goalRepo:     goalRepo,
+		seasonRepo:   seasonRepo,
+		bracketRepo:  bracketRepo,
+		lineupRepo:   lineupRepo,
+		discipline:   discipline,
+		transactor:   transactor,
+		feed:         feed,
+		scheduler:    newMatchScheduler(matchRepo, teamRepo, venueRepo, scheduling),
+		ratings:      ratings,
+		observers:    observers,
 	}
 }
 
@@ -94,12 +166,16 @@ func (uc *matchUseCaseImpl) Create(ctx context.Context, match *entity.Match) err
 		return ErrSameTeamMatch
 	}
 
+	if err := uc.validateSeason(ctx, match.SeasonID); err != nil {
+		return err
+	}
+
 	// Set default status
 	if match.Status == "" {
 		match.Status = entity.MatchStatusScheduled
 	}
 
-	return uc.matchRepo.Create(ctx, match)
+	return uc.scheduler.Schedule(ctx, match)
 }
 
 func (uc *matchUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
@@ -126,13 +202,13 @@ func (uc *matchUseCaseImpl) GetByIDWithDetails(ctx context.Context, id uuid.UUID
 
 func (uc *matchUseCaseImpl) Update(ctx context.Context, match *entity.Match) error {
 	// Check match exists
-	exists, err := uc.matchRepo.Exists(ctx, match.ID)
+	existing, err := uc.matchRepo.FindByID(ctx, match.ID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMatchNotFound
+		}
 		return err
 	}
-	if !exists {
-		return ErrMatchNotFound
-	}
 
 	// Validate teams
 	if match.HomeTeamID == match.AwayTeamID {
@@ -155,18 +231,56 @@ func (uc *matchUseCaseImpl) Update(ctx context.Context, match *entity.Match) err
 		return errors.New("away team not found")
 	}
 
-	return uc.matchRepo.Update(ctx, match)
+	if err := uc.validateSeason(ctx, match.SeasonID); err != nil {
+		return err
+	}
+
+	if err := uc.scheduler.assignVenue(ctx, match); err != nil {
+		return err
+	}
+	// Only a new slot is checked, so existing clashes do not block other edits
+	if rescheduled(existing, match) {
+		if err := uc.scheduler.checkSchedule(ctx, match); err != nil {
+			return err
+		}
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.matchRepo.Update(ctx, match); err != nil {
+			return err
+		}
+		if changesRatings(existing, match) {
+			return uc.ratings.Rebuild(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if match.Status != existing.Status {
+		uc.feed.StatusChanged(ctx, match)
+	}
+	return nil
 }
 
 func (uc *matchUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	exists, err := uc.matchRepo.Exists(ctx, id)
+	match, err := uc.matchRepo.FindByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMatchNotFound
+		}
 		return err
 	}
-	if !exists {
-		return ErrMatchNotFound
-	}
-	return uc.matchRepo.Delete(ctx, id)
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.matchRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if match.Status == entity.MatchStatusCompleted {
+			return uc.ratings.Rebuild(ctx)
+		}
+		return nil
+	})
 }
 
 func (uc *matchUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
@@ -192,6 +306,17 @@ func (uc *matchUseCaseImpl) GetByStatus(ctx context.Context, status entity.Match
 	return uc.matchRepo.FindByStatus(ctx, status, page, limit)
 }
 
+func (uc *matchUseCaseImpl) GetBySeasonID(ctx context.Context, seasonID uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
+	exists, err := uc.seasonRepo.Exists(ctx, seasonID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, ErrSeasonNotFound
+	}
+	return uc.matchRepo.FindBySeasonID(ctx, seasonID, page, limit)
+}
+
 func (uc *matchUseCaseImpl) RecordResult(ctx context.Context, matchID uuid.UUID, input MatchResultInput) (*entity.Match, error) {
 	// Get existing match
 	match, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
@@ -201,55 +326,237 @@ func (uc *matchUseCaseImpl) RecordResult(ctx context.Context, matchID uuid.UUID,
 		}
 		return nil, err
 	}
+	// A live match already has the goals recorded as they were scored
+	if match.Status == entity.MatchStatusOngoing {
+		return nil, ErrMatchInProgress
+	}
 
-	// Check if match is already completed
-	if match.Status == entity.MatchStatusCompleted {
-		// Delete existing goals and record new ones
-		if err := uc.goalRepo.DeleteByMatchID(ctx, matchID); err != nil {
-			return nil, err
-		}
+	// Validate the goals before anything is written
+	goals, err := uc.buildGoals(ctx, match, input)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update match scores
+	wasCompleted := match.Status == entity.MatchStatusCompleted
+
+	// Apply the result so observers can validate it before anything is stored
 	match.HomeScore = &input.HomeScore
 	match.AwayScore = &input.AwayScore
+	match.ExtraTime = input.ExtraTime
+	match.HomePenalties = input.HomePenalties
+	match.AwayPenalties = input.AwayPenalties
 	match.Status = entity.MatchStatusCompleted
+	match.Period = entity.PeriodFullTime
+	match.PeriodStartedAt = nil
+	match.Goals = nil
+	match.Events = nil
+
+	if err := validatePenalties(ctx, uc.bracketRepo, match); err != nil {
+		return nil, err
+	}
+
+	// Store the result, its goals and everything observers derive from it
+	// together so a failure never leaves a half-recorded result behind
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, observer := range uc.observers {
+			if err := observer.ValidateResult(ctx, match); err != nil {
+				return err
+			}
+		}
+
+		// Replace the goals of a previously recorded result
+		if wasCompleted {
+			if err := uc.goalRepo.DeleteByMatchID(ctx, matchID); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.matchRepo.Update(ctx, match); err != nil {
+			return err
+		}
+
+		if len(goals) > 0 {
+			if err := uc.goalRepo.CreateBatch(ctx, goals); err != nil {
+				return err
+			}
+		}
 
-	if err := uc.matchRepo.Update(ctx, match); err != nil {
+		for _, observer := range uc.observers {
+			if err := observer.ResultRecorded(ctx, match); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch updated match with all details
+	updated, err := uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.ScoreChanged(ctx, updated)
+	if !wasCompleted {
+		uc.feed.StatusChanged(ctx, updated)
+	}
+	return updated, nil
+}
+
+// buildGoals checks every goal against the match and the final score and
+// converts them to entities
+func (uc *matchUseCaseImpl) buildGoals(ctx context.Context, match *entity.Match, input MatchResultInput) ([]entity.Goal, error) {
+	lineups, err := loadLineups(ctx, uc.lineupRepo, match.ID)
+	if err != nil {
 		return nil, err
 	}
 
-	// Record goals
 	goals := make([]entity.Goal, len(input.Goals))
+	scorerIDs := make([]uuid.UUID, len(input.Goals))
 	for i, g := range input.Goals {
-		// Validate player exists
-		exists, err := uc.playerRepo.Exists(ctx, g.PlayerID)
+		goal, err := newGoal(ctx, uc.playerRepo, uc.transferRepo, match, lineups, g)
 		if err != nil {
 			return nil, err
 		}
-		if !exists {
-			return nil, ErrPlayerNotFound
-		}
+		goals[i] = *goal
+		scorerIDs[i] = g.PlayerID
+	}
 
-		goals[i] = entity.Goal{
-			MatchID:   matchID,
-			PlayerID:  g.PlayerID,
-			TeamID:    g.TeamID,
-			Minute:    g.Minute,
-			IsOwnGoal: g.IsOwnGoal,
-		}
+	// Suspended players cannot have played
+	if err := uc.discipline.CheckEligibility(ctx, match, scorerIDs); err != nil {
+		return nil, err
 	}
 
-	if len(goals) > 0 {
-		if err := uc.goalRepo.CreateBatch(ctx, goals); err != nil {
-			return nil, err
+	homeScore, awayScore := countGoals(match, goals)
+	if homeScore != input.HomeScore || awayScore != input.AwayScore {
+		return nil, ErrGoalsDoNotMatchScore
+	}
+
+	return goals, nil
+}
+
+// validatePenalties checks the shootout of a match with its final score
+// applied. A shootout needs both tallies, cannot itself end level, and only
+// settles a knockout tie: a single match must end level, while a two-legged
+// tie needs a level aggregate, which the bracket checks.
+func validatePenalties(ctx context.Context, bracketRepo repository.BracketRepository, match *entity.Match) error {
+	if match.HomePenalties == nil && match.AwayPenalties == nil {
+		return nil
+	}
+	if match.HomePenalties == nil || match.AwayPenalties == nil || *match.HomePenalties == *match.AwayPenalties {
+		return ErrInvalidPenalties
+	}
+
+	tie, err := bracketRepo.FindTieByMatchID(ctx, match.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrUnexpectedPenalties
 		}
+		return err
+	}
+	if !tie.TwoLegged && !sameScore(match.HomeScore, match.AwayScore) {
+		return ErrUnexpectedPenalties
 	}
+	return nil
+}
 
-	// Fetch updated match with all details
-	return uc.matchRepo.FindByIDWithDetails(ctx, matchID)
+// newGoal checks a single goal against the match, its lineups and its scorer
+// and converts it to an entity
+func newGoal(ctx context.Context, playerRepo repository.PlayerRepository, transferRepo repository.TransferRepository, match *entity.Match, lineups matchLineups, g GoalInput) (*entity.Goal, error) {
+	if g.TeamID != match.HomeTeamID && g.TeamID != match.AwayTeamID {
+		return nil, ErrGoalTeamNotInMatch
+	}
+	if g.IsPenalty && g.IsOwnGoal {
+		return nil, ErrPenaltyOwnGoal
+	}
+
+	// The scorer must have played for the team the goal is recorded under
+	// on the match day
+	_, teamID, err := findPlayerTeamOn(ctx, playerRepo, transferRepo, g.PlayerID, match.MatchDate)
+	if err != nil {
+		return nil, err
+	}
+	if teamID != g.TeamID {
+		return nil, ErrScorerNotInTeam
+	}
+	// Once a team names its lineup only those players can score
+	if !lineups.allows(g.TeamID, g.PlayerID) {
+		return nil, ErrScorerNotInLineup
+	}
+
+	return &entity.Goal{
+		MatchID:      match.ID,
+		PlayerID:     g.PlayerID,
+		TeamID:       g.TeamID,
+		Minute:       g.Minute,
+		StoppageTime: g.StoppageTime,
+		IsOwnGoal:    g.IsOwnGoal,
+		IsPenalty:    g.IsPenalty,
+	}, nil
+}
+
+// countGoals returns the score the goals add up to
+func countGoals(match *entity.Match, goals []entity.Goal) (int, int) {
+	homeScore, awayScore := 0, 0
+	for _, goal := range goals {
+		if goal.ScoringTeamID(match.HomeTeamID, match.AwayTeamID) == match.HomeTeamID {
+			homeScore++
+		} else {
+			awayScore++
+		}
+	}
+	return homeScore, awayScore
 }
 
 func (uc *matchUseCaseImpl) GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
-	return uc.matchRepo.GetCompletedMatches(ctx, page, limit)
+	return uc.matchRepo.GetCompletedMatches(ctx, nil, page, limit)
+}
+
+// validateSeason checks that the match's season exists when one is set
+func (uc *matchUseCaseImpl) validateSeason(ctx context.Context, seasonID *uuid.UUID) error {
+	if seasonID == nil {
+		return nil
+	}
+	exists, err := uc.seasonRepo.Exists(ctx, *seasonID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrSeasonNotFound
+	}
+	return nil
+}
+
+// changesRatings reports whether an update completes a match, reopens one, or
+// changes the result, teams or kickoff of a completed match
+func changesRatings(existing, match *entity.Match) bool {
+	if existing.Status != entity.MatchStatusCompleted && match.Status != entity.MatchStatusCompleted {
+		return false
+	}
+	return existing.Status != match.Status ||
+		!sameScore(existing.HomeScore, match.HomeScore) ||
+		!sameScore(existing.AwayScore, match.AwayScore) ||
+		existing.HomeTeamID != match.HomeTeamID ||
+		existing.AwayTeamID != match.AwayTeamID ||
+		!existing.MatchDate.Equal(match.MatchDate) ||
+		existing.MatchTime != match.MatchTime
+}
+
+func sameScore(a, b *int) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+// rescheduled reports whether an update moves the match to a new slot, venue
+// or pairing, or brings a cancelled match back
+func rescheduled(existing, match *entity.Match) bool {
+	sameVenue := (existing.VenueID == nil && match.VenueID == nil) ||
+		(existing.VenueID != nil && match.VenueID != nil && *existing.VenueID == *match.VenueID)
+	return !existing.MatchDate.Equal(match.MatchDate) ||
+		existing.MatchTime != match.MatchTime ||
+		existing.HomeTeamID != match.HomeTeamID ||
+		existing.AwayTeamID != match.AwayTeamID ||
+		!sameVenue ||
+		existing.Status == entity.MatchStatusCancelled
 }
@@ -0,0 +1,301 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+)
+
+func TestMatchUseCaseCreate(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness()
+	persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+	persib := h.store.AddTeam("Persib Bandung", "Bandung")
+	arema := h.store.AddTeam("Arema FC", "Malang")
+	disbanded := h.store.AddTeam("Persikota Tangerang", "Tangerang")
+	if err := h.teams.Delete(ctx, disbanded.ID); err != nil {
+		t.Fatal(err)
+	}
+	h.store.AddMatch(persija, arema, kickoff(10))
+
+	matches := h.matchUseCase(usecase.SchedulingRules{RestWindow: 48 * time.Hour})
+	newMatch := func(home, away uuid.UUID, day int, matchTime string) *entity.Match {
+		return &entity.Match{MatchDate: kickoff(day).Truncate(24 * time.Hour), MatchTime: matchTime, HomeTeamID: home, AwayTeamID: away}
+	}
+
+	cases := []struct {
+		name    string
+		match   *entity.Match
+		wantErr string
+	}{
+		{"same team", newMatch(persija.ID, persija.ID, 20, "15:00"), usecase.ErrSameTeamMatch.Error()},
+		{"unknown home team", newMatch(uuid.New(), persib.ID, 20, "15:00"), "home team not found"},
+		{"deleted away team", newMatch(persib.ID, disbanded.ID, 20, "15:00"), "away team not found"},
+		{"invalid time", newMatch(persija.ID, persib.ID, 20, "3pm"), usecase.ErrInvalidMatchTime.Error()},
+		{"team not rested", newMatch(persib.ID, persija.ID, 11, "15:00"), usecase.ErrTeamNotRested.Error()},
+	}
+	for _, tc := range cases {
+		err := matches.Create(ctx, tc.match)
+		if !containsError(err, tc.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tc.name, err, tc.wantErr)
+		}
+	}
+
+	match := newMatch(persib.ID, persija.ID, 12, "15:00")
+	if err := matches.Create(ctx, match); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := matches.GetByID(ctx, match.ID)
+	if err != nil || stored.Status != entity.MatchStatusScheduled {
+		t.Fatalf("got %+v, %v, want a scheduled match", stored, err)
+	}
+	if _, total, _ := matches.GetAll(ctx, 1, 10); total != 2 {
+		t.Fatalf("got %d matches, want only the valid one added", total)
+	}
+}
+
+func TestMatchUseCaseRecordResult(t *testing.T) {
+	ctx := context.Background()
+
+	// setup stores two teams with a striker each and a scheduled match
+	setup := func() (*harness, *entity.Match, *entity.Player, *entity.Player) {
+		h := newHarness()
+		persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+		persib := h.store.AddTeam("Persib Bandung", "Bandung")
+		simic := h.store.AddPlayer(persija, "Marko Simic", 9)
+		silva := h.store.AddPlayer(persib, "David da Silva", 19)
+		return h, h.store.AddMatch(persija, persib, kickoff(10)), simic, silva
+	}
+	goal := func(player *entity.Player, minute int) usecase.GoalInput {
+		return usecase.GoalInput{PlayerID: player.ID, TeamID: player.TeamID, Minute: minute}
+	}
+
+	t.Run("RecordsTheResultAndGoals", func(t *testing.T) {
+		h, match, simic, silva := setup()
+		ownGoal := goal(silva, 80)
+		ownGoal.IsOwnGoal = true
+
+		recorded, err := h.matchUseCase(usecase.SchedulingRules{}).RecordResult(ctx, match.ID, usecase.MatchResultInput{
+			HomeScore: 3,
+			AwayScore: 1,
+			Goals:     []usecase.GoalInput{goal(simic, 60), goal(silva, 30), goal(simic, 10), ownGoal},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recorded.Status != entity.MatchStatusCompleted || recorded.GetResult() != entity.ResultHomeWin {
+			t.Fatalf("got %+v, want a completed home win", recorded)
+		}
+		if len(recorded.Goals) != 4 || recorded.Goals[0].Minute != 10 || recorded.Goals[0].Player.Name != simic.Name {
+			t.Fatalf("got goals %+v, want four starting with the 10th minute", recorded.Goals)
+		}
+
+		scorers, err := h.goals.GetTopScorers(ctx, nil, 10)
+		if err != nil || len(scorers) != 2 || scorers[0].PlayerID != simic.ID || scorers[0].GoalCount != 2 || scorers[1].GoalCount != 1 {
+			t.Fatalf("got top scorers %+v, %v, want the own goal left out", scorers, err)
+		}
+	})
+
+	t.Run("ReplacesTheGoalsOfARecordedResult", func(t *testing.T) {
+		h, match, simic, _ := setup()
+		matches := h.matchUseCase(usecase.SchedulingRules{})
+		if _, err := matches.RecordResult(ctx, match.ID, usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{goal(simic, 10)}}); err != nil {
+			t.Fatal(err)
+		}
+
+		recorded, err := matches.RecordResult(ctx, match.ID, usecase.MatchResultInput{})
+		if err != nil || recorded.GetResult() != entity.ResultDraw || len(recorded.Goals) != 0 {
+			t.Fatalf("got %+v, %v, want a goalless draw", recorded, err)
+		}
+	})
+
+	t.Run("RejectsAnInvalidResult", func(t *testing.T) {
+		h, match, simic, silva := setup()
+		wrongTeam := goal(silva, 20)
+		wrongTeam.TeamID = simic.TeamID
+		penaltyOwnGoal := goal(simic, 20)
+		penaltyOwnGoal.IsOwnGoal, penaltyOwnGoal.IsPenalty = true, true
+		level, shootoutWin := 3, 4
+
+		cases := []struct {
+			name  string
+			id    uuid.UUID
+			input usecase.MatchResultInput
+			want  error
+		}{
+			{"unknown match", uuid.New(), usecase.MatchResultInput{}, usecase.ErrMatchNotFound},
+			{"goals short of the score", match.ID, usecase.MatchResultInput{HomeScore: 2, Goals: []usecase.GoalInput{goal(simic, 10)}}, usecase.ErrGoalsDoNotMatchScore},
+			{"scorer in another team", match.ID, usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{wrongTeam}}, usecase.ErrScorerNotInTeam},
+			{"penalty own goal", match.ID, usecase.MatchResultInput{AwayScore: 1, Goals: []usecase.GoalInput{penaltyOwnGoal}}, usecase.ErrPenaltyOwnGoal},
+			{"unknown scorer", match.ID, usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{{PlayerID: uuid.New(), TeamID: simic.TeamID, Minute: 5}}}, usecase.ErrPlayerNotFound},
+			{"level shootout", match.ID, usecase.MatchResultInput{HomePenalties: &level, AwayPenalties: &level}, usecase.ErrInvalidPenalties},
+			{"shootout outside a knockout tie", match.ID, usecase.MatchResultInput{HomePenalties: &level, AwayPenalties: &shootoutWin}, usecase.ErrUnexpectedPenalties},
+		}
+		matches := h.matchUseCase(usecase.SchedulingRules{})
+		for _, tc := range cases {
+			if _, err := matches.RecordResult(ctx, tc.id, tc.input); !errors.Is(err, tc.want) {
+				t.Errorf("%s: got error %v, want %v", tc.name, err, tc.want)
+			}
+		}
+		requireUnplayed(t, h, match)
+	})
+
+	t.Run("ChecksScorersAgainstTheirTeamOnTheMatchDay", func(t *testing.T) {
+		h, match, simic, silva := setup()
+		persija, persib := simic.TeamID, silva.TeamID
+		h.transfers.record(simic, &entity.Team{BaseEntity: entity.BaseEntity{ID: persib}}, kickoff(20))
+		simic.TeamID = persib
+		if err := h.players.Update(ctx, simic); err != nil {
+			t.Fatal(err)
+		}
+
+		matches := h.matchUseCase(usecase.SchedulingRules{})
+		forNewTeam := usecase.GoalInput{PlayerID: simic.ID, TeamID: persib, Minute: 10}
+		if _, err := matches.RecordResult(ctx, match.ID, usecase.MatchResultInput{AwayScore: 1, Goals: []usecase.GoalInput{forNewTeam}}); !errors.Is(err, usecase.ErrScorerNotInTeam) {
+			t.Fatalf("got error %v, want %v for a team joined after the match", err, usecase.ErrScorerNotInTeam)
+		}
+		forOldTeam := usecase.GoalInput{PlayerID: simic.ID, TeamID: persija, Minute: 10}
+		recorded, err := matches.RecordResult(ctx, match.ID, usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{forOldTeam}})
+		if err != nil || recorded.GetResult() != entity.ResultHomeWin {
+			t.Fatalf("got %+v, %v, want the goal for the team left after the match", recorded, err)
+		}
+	})
+
+	t.Run("RejectsALiveMatch", func(t *testing.T) {
+		h, match, simic, _ := setup()
+		match.Status = entity.MatchStatusOngoing
+		if err := h.matches.Update(ctx, match); err != nil {
+			t.Fatal(err)
+		}
+		h.store.AddGoal(match, simic, 10)
+
+		_, err := h.matchUseCase(usecase.SchedulingRules{}).
+			RecordResult(ctx, match.ID, usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{goal(simic, 10)}})
+		if !errors.Is(err, usecase.ErrMatchInProgress) {
+			t.Fatalf("got error %v, want %v", err, usecase.ErrMatchInProgress)
+		}
+		stored, err := h.matches.FindByIDWithDetails(ctx, match.ID)
+		if err != nil || stored.Status != entity.MatchStatusOngoing || stored.HomeScore != nil || len(stored.Goals) != 1 {
+			t.Fatalf("got %+v, %v, want the live match and its goal untouched", stored, err)
+		}
+	})
+
+	t.Run("ChecksShootoutsAgainstTheScore", func(t *testing.T) {
+		h, match, simic, _ := setup()
+		h.brackets = knockoutTies{ties: map[uuid.UUID]*entity.BracketTie{match.ID: {FirstLegMatchID: &match.ID}}}
+		matches := h.matchUseCase(usecase.SchedulingRules{})
+		five, four := 5, 4
+
+		won := usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{goal(simic, 10)}, HomePenalties: &five, AwayPenalties: &four}
+		if _, err := matches.RecordResult(ctx, match.ID, won); !errors.Is(err, usecase.ErrUnexpectedPenalties) {
+			t.Fatalf("got error %v, want %v for a shootout after a win", err, usecase.ErrUnexpectedPenalties)
+		}
+		requireUnplayed(t, h, match)
+
+		recorded, err := matches.RecordResult(ctx, match.ID, usecase.MatchResultInput{HomePenalties: &five, AwayPenalties: &four})
+		if err != nil || recorded.GetPenaltyResult() == "" {
+			t.Fatalf("got %+v, %v, want a draw settled on penalties", recorded, err)
+		}
+	})
+
+	t.Run("RollsBackWhenAnObserverFails", func(t *testing.T) {
+		h, match, simic, _ := setup()
+		failure := errors.New("standings unavailable")
+
+		_, err := h.matchUseCase(usecase.SchedulingRules{}, failingObserver{err: failure}).
+			RecordResult(ctx, match.ID, usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{goal(simic, 10)}})
+		if !errors.Is(err, failure) {
+			t.Fatalf("got error %v, want %v", err, failure)
+		}
+		requireUnplayed(t, h, match)
+	})
+}
+
+func TestMatchUseCaseRebuildsRatings(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness()
+	persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+	persib := h.store.AddTeam("Persib Bandung", "Bandung")
+	played := h.store.AddMatch(persija, persib, kickoff(3), memory.Completed(2, 0))
+	scheduled := h.store.AddMatch(persib, persija, kickoff(10))
+	matches := h.matchUseCase(usecase.SchedulingRules{})
+
+	steps := []struct {
+		name string
+		run  func() error
+		want int
+	}{
+		{"rescheduling an unplayed match", func() error {
+			moved := *scheduled
+			moved.MatchDate = moved.MatchDate.AddDate(0, 0, 1)
+			return matches.Update(ctx, &moved)
+		}, 0},
+		{"correcting a score", func() error {
+			corrected, score := *played, 3
+			corrected.HomeScore = &score
+			return matches.Update(ctx, &corrected)
+		}, 1},
+		{"deleting an unplayed match", func() error { return matches.Delete(ctx, scheduled.ID) }, 1},
+		{"deleting a played match", func() error { return matches.Delete(ctx, played.ID) }, 2},
+	}
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if h.ratings.count != step.want {
+			t.Fatalf("%s: got %d rebuilds, want %d", step.name, h.ratings.count, step.want)
+		}
+	}
+}
+
+func TestMatchUseCaseGetCompletedMatches(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness()
+	persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+	persib := h.store.AddTeam("Persib Bandung", "Bandung")
+	h.store.AddMatch(persija, persib, kickoff(3), memory.Completed(2, 0))
+	h.store.AddMatch(persib, persija, kickoff(10), memory.Completed(1, 1))
+	h.store.AddMatch(persija, persib, kickoff(17))
+
+	matches, total, err := h.matchUseCase(usecase.SchedulingRules{}).GetCompletedMatches(ctx, 1, 1)
+	if err != nil || total != 2 || len(matches) != 1 || matches[0].HomeTeam.Name != persib.Name {
+		t.Fatalf("got %+v of %d, %v, want the latest of two completed matches", matches, total, err)
+	}
+}
+
+// failingObserver accepts every result but fails once it is stored
+type failingObserver struct {
+	err error
+}
+
+func (failingObserver) ValidateResult(ctx context.Context, match *entity.Match) error {
+	return nil
+}
+
+func (o failingObserver) ResultRecorded(ctx context.Context, match *entity.Match) error {
+	return o.err
+}
+
+// requireUnplayed fails unless the match is still scheduled without goals
+func requireUnplayed(t *testing.T, h *harness, match *entity.Match) {
+	t.Helper()
+	stored, err := h.matches.FindByIDWithDetails(context.Background(), match.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != entity.MatchStatusScheduled || stored.HomeScore != nil || len(stored.Goals) != 0 {
+		t.Fatalf("got %+v with goals %+v, want the match unplayed", stored, stored.Goals)
+	}
+}
+
+// containsError reports whether err's message contains want
+func containsError(err error, want string) bool {
+	return err != nil && strings.Contains(err.Error(), want)
+}
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOfficialNotFound             = errors.New("official not found")
+	ErrInvalidOfficialGrade         = errors.New("official grade must be international, national, regional or local")
+	ErrInvalidOfficialRole          = errors.New("official role must be referee, assistant_1, assistant_2 or fourth_official")
+	ErrOfficialHasAccount           = errors.New("official already has an account")
+	ErrRefereeRequired              = errors.New("a match needs a referee")
+	ErrDuplicateOfficialRole        = errors.New("each official role can only be filled once per match")
+	ErrDuplicateMatchOfficial       = errors.New("an official can only hold one role per match")
+	ErrOfficialCertificationExpired = errors.New("official's certification has expired by the match date")
+	ErrOfficialDoubleBooked         = errors.New("official is already assigned to another match on that date")
+	ErrOfficialNotAssigned          = errors.New("official is not assigned to this match")
+)
+
+// OfficialSchedule represents an official and the matches they are assigned to
+type OfficialSchedule struct {
+	Official    *entity.Official
+	Assignments []entity.MatchOfficial // In kickoff order
+}
+
+// OfficialUseCase defines the interface for match official operations
+type OfficialUseCase interface {
+	Create(ctx context.Context, official *entity.Official) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Official, error)
+	Update(ctx context.Context, official *entity.Official) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetAll(ctx context.Context, page, limit int) ([]entity.Official, int64, error)
+	// CreateAccount registers a user with the official role the official logs
+	// in with
+	CreateAccount(ctx context.Context, officialID uuid.UUID, email, password string) (*entity.User, error)
+	// AssignOfficials replaces the officials assigned to the match
+	AssignOfficials(ctx context.Context, matchID uuid.UUID, assignments []entity.MatchOfficial) ([]entity.MatchOfficial, error)
+	GetMatchOfficials(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error)
+	GetSchedule(ctx context.Context, officialID uuid.UUID, dateRange DateRange) (*OfficialSchedule, error)
+	// GetScheduleByUserID returns the schedule of the official the user logs in as
+	GetScheduleByUserID(ctx context.Context, userID uuid.UUID, dateRange DateRange) (*OfficialSchedule, error)
+	// SubmitReport records the result of a match the user's official is
+	// assigned to
+	SubmitReport(ctx context.Context, userID, matchID uuid.UUID, input MatchResultInput) (*entity.Match, error)
+}
+
+type officialUseCaseImpl struct {
+	officialRepo repository.OfficialRepository
+	matchRepo    repository.MatchRepository
+	auth         AuthUseCase
+	matches      MatchUseCase
+	transactor   repository.Transactor
+}
+
+// NewOfficialUseCase creates a new instance of OfficialUseCase
+func NewOfficialUseCase(
+	officialRepo repository.OfficialRepository,
+	matchRepo repository.MatchRepository,
+	auth AuthUseCase,
+	matches MatchUseCase,
+	transactor repository.Transactor,
+) OfficialUseCase {
+	return &officialUseCaseImpl{
+		officialRepo: officialRepo,
+		matchRepo:    matchRepo,
+		auth:         auth,
+		matches:      matches,
+		transactor:   transactor,
+	}
+}
+
+func (uc *officialUseCaseImpl) Create(ctx context.Context, official *entity.Official) error {
+	if !entity.IsValidOfficialGrade(official.Grade) {
+		return ErrInvalidOfficialGrade
+	}
+	return uc.officialRepo.Create(ctx, official)
+}
+
+func (uc *officialUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Official, error) {
+	official, err := uc.officialRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOfficialNotFound
+		}
+		return nil, err
+	}
+	return official, nil
+}
+
+func (uc *officialUseCaseImpl) Update(ctx context.Context, official *entity.Official) error {
+	exists, err := uc.officialRepo.Exists(ctx, official.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrOfficialNotFound
+	}
+	if !entity.IsValidOfficialGrade(official.Grade) {
+		return ErrInvalidOfficialGrade
+	}
+	return uc.officialRepo.Update(ctx, official)
+}
+
+func (uc *officialUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	exists, err := uc.officialRepo.Exists(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrOfficialNotFound
+	}
+	return uc.officialRepo.Delete(ctx, id)
+}
+
+func (uc *officialUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Official, int64, error) {
+	return uc.officialRepo.FindAll(ctx, page, limit)
+}
+
+func (uc *officialUseCaseImpl) CreateAccount(ctx context.Context, officialID uuid.UUID, email, password string) (*entity.User, error) {
+	official, err := uc.GetByID(ctx, officialID)
+	if err != nil {
+		return nil, err
+	}
+	if official.UserID != nil {
+		return nil, ErrOfficialHasAccount
+	}
+
+	var user *entity.User
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err = uc.auth.Register(ctx, official.Name, email, password, entity.RoleOfficial)
+		if err != nil {
+			return err
+		}
+		official.UserID = &user.ID
+		return uc.officialRepo.Update(ctx, official)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (uc *officialUseCaseImpl) AssignOfficials(ctx context.Context, matchID uuid.UUID, assignments []entity.MatchOfficial) ([]entity.MatchOfficial, error) {
+	match, err := uc.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, err
+	}
+	if match.Status == entity.MatchStatusCompleted {
+		return nil, ErrMatchAlreadyPlayed
+	}
+
+	officialIDs, err := validateAssignments(assignments)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkOfficials(ctx, match, officialIDs); err != nil {
+		return nil, err
+	}
+
+	for i := range assignments {
+		assignments[i].MatchID = matchID
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.officialRepo.DeleteAssignmentsByMatchID(ctx, matchID); err != nil {
+			return err
+		}
+		return uc.officialRepo.CreateAssignments(ctx, assignments)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.officialRepo.FindAssignmentsByMatchID(ctx, matchID)
+}
+
+func (uc *officialUseCaseImpl) GetMatchOfficials(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error) {
+	exists, err := uc.matchRepo.Exists(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrMatchNotFound
+	}
+	return uc.officialRepo.FindAssignmentsByMatchID(ctx, matchID)
+}
+
+func (uc *officialUseCaseImpl) GetSchedule(ctx context.Context, officialID uuid.UUID, dateRange DateRange) (*OfficialSchedule, error) {
+	official, err := uc.GetByID(ctx, officialID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.schedule(ctx, official, dateRange)
+}
+
+func (uc *officialUseCaseImpl) GetScheduleByUserID(ctx context.Context, userID uuid.UUID, dateRange DateRange) (*OfficialSchedule, error) {
+	official, err := uc.findByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.schedule(ctx, official, dateRange)
+}
+
+func (uc *officialUseCaseImpl) SubmitReport(ctx context.Context, userID, matchID uuid.UUID, input MatchResultInput) (*entity.Match, error) {
+	official, err := uc.findByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := uc.officialRepo.FindAssignmentsByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	var assignment *entity.MatchOfficial
+	for i := range assignments {
+		if assignments[i].OfficialID == official.ID {
+			assignment = &assignments[i]
+		}
+	}
+	if assignment == nil {
+		return nil, ErrOfficialNotAssigned
+	}
+
+	// The report and the result are stored together. The result goes last,
+	// so it only reaches the match feed once the report is written.
+	var match *entity.Match
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		assignment.ReportedAt = &now
+		if err := uc.officialRepo.UpdateAssignment(ctx, assignment); err != nil {
+			return err
+		}
+		match, err = uc.matches.RecordResult(ctx, matchID, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// findByUserID returns the official the user logs in as
+func (uc *officialUseCaseImpl) findByUserID(ctx context.Context, userID uuid.UUID) (*entity.Official, error) {
+	official, err := uc.officialRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOfficialNotFound
+		}
+		return nil, err
+	}
+	return official, nil
+}
+
+func (uc *officialUseCaseImpl) schedule(ctx context.Context, official *entity.Official, dateRange DateRange) (*OfficialSchedule, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, err
+	}
+	assignments, err := uc.officialRepo.FindAssignmentsByOfficialID(ctx, official.ID, dateRange.From, dateRange.To)
+	if err != nil {
+		return nil, err
+	}
+	return &OfficialSchedule{Official: official, Assignments: assignments}, nil
+}
+
+// checkOfficials checks that every official exists, is certified on the match
+// date and is not assigned to another match that day
+func (uc *officialUseCaseImpl) checkOfficials(ctx context.Context, match *entity.Match, officialIDs []uuid.UUID) error {
+	officials, err := uc.officialRepo.FindByIDs(ctx, officialIDs)
+	if err != nil {
+		return err
+	}
+	if len(officials) != len(officialIDs) {
+		return ErrOfficialNotFound
+	}
+
+	names := make(map[uuid.UUID]string, len(officials))
+	for _, official := range officials {
+		if !official.IsCertifiedOn(match.MatchDate) {
+			return fmt.Errorf("%w: %s", ErrOfficialCertificationExpired, official.Name)
+		}
+		names[official.ID] = official.Name
+	}
+
+	booked, err := uc.officialRepo.FindAssignmentsOnDate(ctx, officialIDs, match.MatchDate, match.ID)
+	if err != nil {
+		return err
+	}
+	if len(booked) > 0 {
+		return fmt.Errorf("%w: %s is assigned to match %s", ErrOfficialDoubleBooked, names[booked[0].OfficialID], booked[0].MatchID)
+	}
+	return nil
+}
+
+// validateAssignments checks the roles of the assignments and returns the
+// assigned officials
+func validateAssignments(assignments []entity.MatchOfficial) ([]uuid.UUID, error) {
+	roles := make(map[entity.OfficialRole]bool, len(assignments))
+	officialIDs := make([]uuid.UUID, 0, len(assignments))
+	seen := make(map[uuid.UUID]bool, len(assignments))
+	for _, assignment := range assignments {
+		if !entity.IsValidOfficialRole(assignment.Role) {
+			return nil, ErrInvalidOfficialRole
+		}
+		if roles[assignment.Role] {
+			return nil, ErrDuplicateOfficialRole
+		}
+		roles[assignment.Role] = true
+		if seen[assignment.OfficialID] {
+			return nil, ErrDuplicateMatchOfficial
+		}
+		seen[assignment.OfficialID] = true
+		officialIDs = append(officialIDs, assignment.OfficialID)
+	}
+	if !roles[entity.OfficialRoleReferee] {
+		return nil, ErrRefereeRequired
+	}
+	return officialIDs, nil
+}
@@ -0,0 +1,91 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"gorm.io/gorm"
+)
+
+// officialRoster is an OfficialRepository holding officials and their match
+// assignments
+type officialRoster struct {
+	repository.OfficialRepository
+	officials   []entity.Official
+	assignments []entity.MatchOfficial
+}
+
+func (r *officialRoster) addOfficial(name string) entity.Official {
+	userID := uuid.New()
+	official := entity.Official{BaseEntity: entity.BaseEntity{ID: uuid.New()}, Name: name, Grade: entity.OfficialGradeNational, UserID: &userID}
+	r.officials = append(r.officials, official)
+	return official
+}
+
+func (r *officialRoster) assign(official entity.Official, match *entity.Match, role entity.OfficialRole) {
+	r.assignments = append(r.assignments, entity.MatchOfficial{BaseEntity: entity.BaseEntity{ID: uuid.New()}, MatchID: match.ID, OfficialID: official.ID, Role: role})
+}
+
+func (r *officialRoster) FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.Official, error) {
+	for _, official := range r.officials {
+		if official.UserID != nil && *official.UserID == userID {
+			return &official, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *officialRoster) FindAssignmentsByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error) {
+	var assignments []entity.MatchOfficial
+	for _, assignment := range r.assignments {
+		if assignment.MatchID == matchID {
+			assignments = append(assignments, assignment)
+		}
+	}
+	return assignments, nil
+}
+
+func (r *officialRoster) UpdateAssignment(ctx context.Context, assignment *entity.MatchOfficial) error {
+	for i := range r.assignments {
+		if r.assignments[i].ID == assignment.ID {
+			r.assignments[i] = *assignment
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func TestOfficialUseCaseSubmitReport(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness()
+	persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+	persib := h.store.AddTeam("Persib Bandung", "Bandung")
+	simic := h.store.AddPlayer(persija, "Marko Simic", 9)
+	match := h.store.AddMatch(persija, persib, kickoff(10))
+
+	roster := &officialRoster{}
+	referee := roster.addOfficial("Thoriq Alkatiri")
+	other := roster.addOfficial("Yudi Nurcahya")
+	roster.assign(referee, match, entity.OfficialRoleReferee)
+
+	officials := usecase.NewOfficialUseCase(roster, h.matches, nil, h.matchUseCase(usecase.SchedulingRules{}), h.transactor)
+	result := usecase.MatchResultInput{HomeScore: 1, Goals: []usecase.GoalInput{{PlayerID: simic.ID, TeamID: persija.ID, Minute: 30}}}
+
+	if _, err := officials.SubmitReport(ctx, *other.UserID, match.ID, result); !errors.Is(err, usecase.ErrOfficialNotAssigned) {
+		t.Fatalf("got error %v, want %v for an official on another match", err, usecase.ErrOfficialNotAssigned)
+	}
+	requireUnplayed(t, h, match)
+
+	recorded, err := officials.SubmitReport(ctx, *referee.UserID, match.ID, result)
+	if err != nil || recorded.GetResult() != entity.ResultHomeWin {
+		t.Fatalf("got %+v, %v, want the referee's home win", recorded, err)
+	}
+	if roster.assignments[0].ReportedAt == nil {
+		t.Fatal("the referee's assignment was not marked as reported")
+	}
+}
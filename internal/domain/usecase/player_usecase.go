@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -26,19 +27,30 @@ type PlayerUseCase interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error)
 	GetByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error)
+	// GetAvailableByTeamID returns the team's players without an availability record covering the date
+	GetAvailableByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time, page, limit int) ([]entity.Player, int64, error)
 	Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error)
 }
 
 type playerUseCaseImpl struct {
-	playerRepo repository.PlayerRepository
-	teamRepo   repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	teamRepo     repository.TeamRepository
+	transferRepo repository.TransferRepository
+	transactor   repository.Transactor
 }
 
 // NewPlayerUseCase creates a new instance of PlayerUseCase
-func NewPlayerUseCase(playerRepo repository.PlayerRepository, teamRepo repository.TeamRepository) PlayerUseCase {
+func NewPlayerUseCase(
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	transferRepo repository.TransferRepository,
+	transactor repository.Transactor,
+) PlayerUseCase {
 	return &playerUseCaseImpl{
-		playerRepo: playerRepo,
-		teamRepo:   teamRepo,
+		playerRepo:   playerRepo,
+		teamRepo:     teamRepo,
+		transferRepo: transferRepo,
+		transactor:   transactor,
 	}
 }
 
@@ -98,16 +110,16 @@ func (uc *playerUseCaseImpl) GetByIDWithTeam(ctx context.Context, id uuid.UUID)
 
 func (uc *playerUseCaseImpl) Update(ctx context.Context, player *entity.Player) error {
 	// Check player exists
-	exists, err := uc.playerRepo.Exists(ctx, player.ID)
+	existing, err := uc.playerRepo.FindByID(ctx, player.ID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPlayerNotFound
+		}
 		return err
 	}
-	if !exists {
-		return ErrPlayerNotFound
-	}
 
 	// Validate team exists
-	exists, err = uc.teamRepo.Exists(ctx, player.TeamID)
+	exists, err := uc.teamRepo.Exists(ctx, player.TeamID)
 	if err != nil {
 		return err
 	}
@@ -134,7 +146,24 @@ func (uc *playerUseCaseImpl) Update(ctx context.Context, player *entity.Player)
 		return ErrJerseyNumberTaken
 	}
 
-	return uc.playerRepo.Update(ctx, player)
+	if existing.TeamID == player.TeamID {
+		return uc.playerRepo.Update(ctx, player)
+	}
+
+	// A change of team is a free transfer as of today, so the player's
+	// career keeps the team they left
+	transfer := &entity.Transfer{
+		PlayerID:     player.ID,
+		FromTeamID:   existing.TeamID,
+		ToTeamID:     player.TeamID,
+		TransferDate: time.Now().UTC().Truncate(24 * time.Hour),
+	}
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.transferRepo.Create(ctx, transfer); err != nil {
+			return err
+		}
+		return uc.playerRepo.Update(ctx, player)
+	})
 }
 
 func (uc *playerUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
@@ -165,6 +194,18 @@ func (uc *playerUseCaseImpl) GetByTeamID(ctx context.Context, teamID uuid.UUID,
 	return uc.playerRepo.FindByTeamID(ctx, teamID, page, limit)
 }
 
+func (uc *playerUseCaseImpl) GetAvailableByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time, page, limit int) ([]entity.Player, int64, error) {
+	exists, err := uc.teamRepo.Exists(ctx, teamID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exists {
+		return nil, 0, ErrTeamNotFound
+	}
+
+	return uc.playerRepo.FindAvailableByTeamID(ctx, teamID, date, page, limit)
+}
+
 func (uc *playerUseCaseImpl) Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error) {
 	return uc.playerRepo.Search(ctx, query, page, limit)
 }
@@ -0,0 +1,51 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+)
+
+func TestPlayerUseCaseCreate(t *testing.T) {
+	ctx := context.Background()
+	h := newHarness()
+	persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+	persib := h.store.AddTeam("Persib Bandung", "Bandung")
+	h.store.AddPlayer(persija, "Marko Simic", 9)
+	released := h.store.AddPlayer(persija, "Ezra Walian", 11)
+	if err := h.players.Delete(ctx, released.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	newPlayer := func(teamID uuid.UUID, position entity.PlayerPosition, jerseyNumber int) *entity.Player {
+		return &entity.Player{TeamID: teamID, Name: "Riko Simanjuntak", Height: 165, Weight: 60, Position: position, JerseyNumber: jerseyNumber}
+	}
+	cases := []struct {
+		name   string
+		player *entity.Player
+		want   error
+	}{
+		{"unknown team", newPlayer(uuid.New(), entity.PositionForward, 25), usecase.ErrTeamNotFound},
+		{"invalid position", newPlayer(persija.ID, "winger", 25), usecase.ErrInvalidPosition},
+		{"jersey number too low", newPlayer(persija.ID, entity.PositionForward, 0), usecase.ErrInvalidJerseyNumber},
+		{"jersey number too high", newPlayer(persija.ID, entity.PositionForward, 100), usecase.ErrInvalidJerseyNumber},
+		{"jersey number taken", newPlayer(persija.ID, entity.PositionForward, 9), usecase.ErrJerseyNumberTaken},
+		{"jersey number taken in another team", newPlayer(persib.ID, entity.PositionForward, 9), nil},
+		{"jersey number of a released player", newPlayer(persija.ID, entity.PositionForward, 11), nil},
+	}
+	players := h.playerUseCase()
+	for _, tc := range cases {
+		if err := players.Create(ctx, tc.player); !errors.Is(err, tc.want) {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	squad, total, err := players.GetByTeamID(ctx, persija.ID, 1, 10)
+	if err != nil || total != 2 || squad[0].JerseyNumber != 9 || squad[1].JerseyNumber != 11 {
+		t.Fatalf("got %+v of %d, %v, want numbers 9 and 11", squad, total, err)
+	}
+}
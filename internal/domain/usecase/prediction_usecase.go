@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMatchNotScheduled = errors.New("predictions are only available for scheduled matches")
+)
+
+// predictionMaxGoals is the highest score per team the model sums over; the
+// probability of more is negligible
+const predictionMaxGoals = 10
+
+// predictionPriorMatches is how many league-average matches every team's
+// record is blended with, so a short or goalless record does not predict a
+// certain result
+const predictionPriorMatches = 2
+
+// Average goals per match assumed before any match is completed
+const (
+	defaultHomeGoals = 1.5
+	defaultAwayGoals = 1.2
+)
+
+// MatchPrediction represents the predicted outcome of a scheduled match
+type MatchPrediction struct {
+	Match        *entity.Match
+	HomeExpected float64 // Expected home goals
+	AwayExpected float64 // Expected away goals
+	HomeWin      float64
+	Draw         float64
+	AwayWin      float64
+	HomeScore    int // Most likely scoreline
+	AwayScore    int
+	SampleSize   int // Completed matches the model was fitted from
+}
+
+// PredictionUseCase defines the interface for match outcome predictions
+type PredictionUseCase interface {
+	Predict(ctx context.Context, matchID uuid.UUID) (*MatchPrediction, error)
+}
+
+type predictionUseCaseImpl struct {
+	matchRepo repository.MatchRepository
+}
+
+// NewPredictionUseCase creates a new instance of PredictionUseCase
+func NewPredictionUseCase(matchRepo repository.MatchRepository) PredictionUseCase {
+	return &predictionUseCaseImpl{matchRepo: matchRepo}
+}
+
+// Predict fits the teams' strengths from every match completed before the
+// match kicks off
+func (uc *predictionUseCaseImpl) Predict(ctx context.Context, matchID uuid.UUID) (*MatchPrediction, error) {
+	match, err := uc.matchRepo.FindByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, err
+	}
+	if match.Status != entity.MatchStatusScheduled {
+		return nil, ErrMatchNotScheduled
+	}
+
+	completed, err := uc.matchRepo.FindAllCompleted(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var history []entity.Match
+	for _, m := range completed {
+		if m.KicksOffBefore(match) {
+			history = append(history, m)
+		}
+	}
+
+	prediction := PredictMatch(match, history)
+	return &prediction, nil
+}
+
+// teamGoalRecord represents a team's goals for and against
+type teamGoalRecord struct {
+	Played       int
+	GoalsFor     int
+	GoalsAgainst int
+}
+
+// PredictMatch predicts a match with a Poisson model. Each team's attack and
+// defence strength is its goals for and against per match relative to the
+// league average, and each side's expected goals are the league's home or
+// away average scaled by its attack and the opponent's defence. The result
+// only depends on its arguments.
+func PredictMatch(match *entity.Match, history []entity.Match) MatchPrediction {
+	records := make(map[uuid.UUID]*teamGoalRecord)
+	record := func(teamID uuid.UUID) *teamGoalRecord {
+		if _, ok := records[teamID]; !ok {
+			records[teamID] = &teamGoalRecord{}
+		}
+		return records[teamID]
+	}
+
+	sample, homeGoals, awayGoals := 0, 0, 0
+	for _, m := range history {
+		if m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		sample++
+		homeGoals += *m.HomeScore
+		awayGoals += *m.AwayScore
+
+		home, away := record(m.HomeTeamID), record(m.AwayTeamID)
+		home.Played++
+		home.GoalsFor += *m.HomeScore
+		home.GoalsAgainst += *m.AwayScore
+		away.Played++
+		away.GoalsFor += *m.AwayScore
+		away.GoalsAgainst += *m.HomeScore
+	}
+
+	avgHome, avgAway := defaultHomeGoals, defaultAwayGoals
+	if sample > 0 && homeGoals+awayGoals > 0 {
+		avgHome = float64(homeGoals) / float64(sample)
+		avgAway = float64(awayGoals) / float64(sample)
+	}
+	avgTeam := (avgHome + avgAway) / 2
+
+	homeAttack, homeDefence := record(match.HomeTeamID).strength(avgTeam)
+	awayAttack, awayDefence := record(match.AwayTeamID).strength(avgTeam)
+
+	prediction := MatchPrediction{
+		Match:        match,
+		HomeExpected: avgHome * homeAttack * awayDefence,
+		AwayExpected: avgAway * awayAttack * homeDefence,
+		SampleSize:   sample,
+	}
+
+	best, total := -1.0, 0.0
+	for h := 0; h <= predictionMaxGoals; h++ {
+		for a := 0; a <= predictionMaxGoals; a++ {
+			p := poisson(prediction.HomeExpected, h) * poisson(prediction.AwayExpected, a)
+			total += p
+			switch {
+			case h > a:
+				prediction.HomeWin += p
+			case h < a:
+				prediction.AwayWin += p
+			default:
+				prediction.Draw += p
+			}
+			if p > best {
+				best = p
+				prediction.HomeScore, prediction.AwayScore = h, a
+			}
+		}
+	}
+
+	// Spread the mass beyond the score cap so the outcomes add up to one
+	prediction.HomeWin /= total
+	prediction.Draw /= total
+	prediction.AwayWin /= total
+
+	return prediction
+}
+
+// strength returns the team's attack and defence relative to a league
+// average of goals per team per match, blended with league-average matches
+func (r *teamGoalRecord) strength(avgTeam float64) (attack, defence float64) {
+	prior := predictionPriorMatches * avgTeam
+	matches := float64(r.Played+predictionPriorMatches) * avgTeam
+	attack = (float64(r.GoalsFor) + prior) / matches
+	defence = (float64(r.GoalsAgainst) + prior) / matches
+	return attack, defence
+}
+
+// poisson returns the probability of k goals when lambda are expected
+func poisson(lambda float64, k int) float64 {
+	p := math.Exp(-lambda)
+	for i := 1; i <= k; i++ {
+		p *= lambda / float64(i)
+	}
+	return p
+}
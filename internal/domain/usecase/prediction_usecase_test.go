@@ -0,0 +1,82 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+)
+
+func TestPredictionUseCasePredict(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("FitsTheMatchesBeforeKickoff", func(t *testing.T) {
+		h := newHarness()
+		persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+		persib := h.store.AddTeam("Persib Bandung", "Bandung")
+		h.store.AddMatch(persija, persib, kickoff(3), memory.Completed(3, 0))
+		h.store.AddMatch(persib, persija, kickoff(10), memory.Completed(0, 2))
+		match := h.store.AddMatch(persija, persib, kickoff(17))
+		// Played after the predicted match, so left out of the model
+		h.store.AddMatch(persib, persija, kickoff(24), memory.Completed(5, 0))
+
+		prediction, err := usecase.NewPredictionUseCase(h.matches).Predict(ctx, match.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// League averages of 1.5 home and 1 away goals, Persija attacking at
+		// 1.5 and defending at 0.5 after two clean sheets, Persib the reverse
+		requirePrediction(t, prediction, 2, 3.375, 0.25, 3, 0)
+		if prediction.HomeWin < 0.85 || prediction.AwayWin > prediction.Draw {
+			t.Fatalf("got %+v, want a clear home win", prediction)
+		}
+	})
+
+	t.Run("FallsBackToThePriorWithoutHistory", func(t *testing.T) {
+		h := newHarness()
+		persija := h.store.AddTeam("Persija Jakarta", "Jakarta")
+		persib := h.store.AddTeam("Persib Bandung", "Bandung")
+		match := h.store.AddMatch(persija, persib, kickoff(17))
+
+		prediction, err := usecase.NewPredictionUseCase(h.matches).Predict(ctx, match.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		requirePrediction(t, prediction, 0, 1.5, 1.2, 1, 1)
+		if prediction.HomeWin <= prediction.AwayWin {
+			t.Fatalf("got %+v, want home advantage", prediction)
+		}
+	})
+
+	t.Run("RejectsAPlayedMatch", func(t *testing.T) {
+		h := newHarness()
+		played := h.store.AddMatch(h.store.AddTeam("Persija Jakarta", "Jakarta"), h.store.AddTeam("Persib Bandung", "Bandung"), kickoff(3), memory.Completed(1, 0))
+
+		if _, err := usecase.NewPredictionUseCase(h.matches).Predict(ctx, played.ID); !errors.Is(err, usecase.ErrMatchNotScheduled) {
+			t.Fatalf("got error %v, want %v", err, usecase.ErrMatchNotScheduled)
+		}
+	})
+}
+
+// requirePrediction fails unless the prediction was fitted from sample
+// matches, expects the given goals, names the scoreline and its outcome
+// probabilities add up to one
+func requirePrediction(t *testing.T, prediction *usecase.MatchPrediction, sample int, homeExpected, awayExpected float64, homeScore, awayScore int) {
+	t.Helper()
+	const tolerance = 1e-9
+	if prediction.SampleSize != sample {
+		t.Errorf("got a sample of %d matches, want %d", prediction.SampleSize, sample)
+	}
+	if math.Abs(prediction.HomeExpected-homeExpected) > tolerance || math.Abs(prediction.AwayExpected-awayExpected) > tolerance {
+		t.Errorf("got expected goals %v-%v, want %v-%v", prediction.HomeExpected, prediction.AwayExpected, homeExpected, awayExpected)
+	}
+	if prediction.HomeScore != homeScore || prediction.AwayScore != awayScore {
+		t.Errorf("got scoreline %d-%d, want %d-%d", prediction.HomeScore, prediction.AwayScore, homeScore, awayScore)
+	}
+	if sum := prediction.HomeWin + prediction.Draw + prediction.AwayWin; math.Abs(sum-1) > tolerance {
+		t.Errorf("got outcome probabilities adding up to %v, want 1", sum)
+	}
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidEloSettings = errors.New("elo K factor and initial rating must be positive and home advantage non-negative")
+)
+
+// EloSettings configures the team rating engine
+type EloSettings struct {
+	InitialRating float64 // Rating of a team before its first match
+	KFactor       float64 // Largest change a single result can make
+	HomeAdvantage float64 // Rating points added to the home side's expectation
+}
+
+// Validate checks the Elo settings
+func (s EloSettings) Validate() error {
+	if s.KFactor <= 0 || s.InitialRating <= 0 || s.HomeAdvantage < 0 {
+		return ErrInvalidEloSettings
+	}
+	return nil
+}
+
+// change returns the rating points the home team gains from the result, and
+// the away team loses. Matches decided on penalties count as draws.
+func (s EloSettings) change(homeRating, awayRating float64, homeScore, awayScore int) float64 {
+	expected := 1 / (1 + math.Pow(10, (awayRating-homeRating-s.HomeAdvantage)/400))
+
+	actual := 0.5
+	switch {
+	case homeScore > awayScore:
+		actual = 1
+	case homeScore < awayScore:
+		actual = 0
+	}
+
+	return s.KFactor * goalDifferenceMultiplier(abs(homeScore-awayScore)) * (actual - expected)
+}
+
+// goalDifferenceMultiplier weighs a result by its margin, so a heavy win
+// moves ratings further than a narrow one
+func goalDifferenceMultiplier(margin int) float64 {
+	switch {
+	case margin <= 1:
+		return 1
+	case margin == 2:
+		return 1.5
+	default:
+		return float64(11+margin) / 8
+	}
+}
+
+// TeamRatingHistory represents a team's current rating and how it got there
+type TeamRatingHistory struct {
+	Team    *entity.Team
+	Rating  float64
+	History []entity.RatingHistory // In match order
+}
+
+// RatingUseCase defines the interface for Elo team ratings. It keeps ratings
+// up to date as a MatchResultObserver.
+type RatingUseCase interface {
+	MatchResultObserver
+	// Rebuild replays every completed match in order, replacing all ratings
+	Rebuild(ctx context.Context) error
+	// GetRatings returns every rated team, highest rating first
+	GetRatings(ctx context.Context) ([]entity.TeamRating, error)
+	GetHistory(ctx context.Context, teamID uuid.UUID) (*TeamRatingHistory, error)
+}
+
+type ratingUseCaseImpl struct {
+	ratingRepo repository.RatingRepository
+	matchRepo  repository.MatchRepository
+	teamRepo   repository.TeamRepository
+	settings   EloSettings
+}
+
+// NewRatingUseCase creates a new instance of RatingUseCase
+func NewRatingUseCase(
+	ratingRepo repository.RatingRepository,
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	settings EloSettings,
+) RatingUseCase {
+	return &ratingUseCaseImpl{
+		ratingRepo: ratingRepo,
+		matchRepo:  matchRepo,
+		teamRepo:   teamRepo,
+		settings:   settings,
+	}
+}
+
+func (uc *ratingUseCaseImpl) Rebuild(ctx context.Context) error {
+	matches, err := uc.matchRepo.FindAllCompleted(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ratings := make(map[uuid.UUID]*entity.TeamRating)
+	var order []uuid.UUID
+	var history []entity.RatingHistory
+	for i := range matches {
+		for _, teamID := range []uuid.UUID{matches[i].HomeTeamID, matches[i].AwayTeamID} {
+			if _, ok := ratings[teamID]; !ok {
+				ratings[teamID] = &entity.TeamRating{TeamID: teamID, Rating: uc.settings.InitialRating}
+				order = append(order, teamID)
+			}
+		}
+		history = append(history, uc.rate(&matches[i], ratings)...)
+	}
+
+	result := make([]entity.TeamRating, len(order))
+	for i, teamID := range order {
+		result[i] = *ratings[teamID]
+	}
+	return uc.ratingRepo.ReplaceAll(ctx, result, history)
+}
+
+// ValidateResult implements MatchResultObserver; ratings never block a result
+func (uc *ratingUseCaseImpl) ValidateResult(ctx context.Context, match *entity.Match) error {
+	return nil
+}
+
+// ResultRecorded implements MatchResultObserver. A result after every rated
+// match only moves its two teams; a corrected or late result changes every
+// rating that followed, so all matches are replayed.
+func (uc *ratingUseCaseImpl) ResultRecorded(ctx context.Context, match *entity.Match) error {
+	latest, err := uc.ratingRepo.FindLatestHistory(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if latest != nil && (latest.Match == nil || !ratedBefore(latest.Match, match)) {
+		return uc.Rebuild(ctx)
+	}
+
+	existing, err := uc.ratingRepo.FindByTeamIDs(ctx, []uuid.UUID{match.HomeTeamID, match.AwayTeamID})
+	if err != nil {
+		return err
+	}
+	ratings := make(map[uuid.UUID]*entity.TeamRating, 2)
+	for i := range existing {
+		ratings[existing[i].TeamID] = &existing[i]
+	}
+	for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+		if _, ok := ratings[teamID]; !ok {
+			ratings[teamID] = &entity.TeamRating{TeamID: teamID, Rating: uc.settings.InitialRating}
+		}
+	}
+
+	history := uc.rate(match, ratings)
+	return uc.ratingRepo.Apply(ctx, []entity.TeamRating{*ratings[match.HomeTeamID], *ratings[match.AwayTeamID]}, history)
+}
+
+func (uc *ratingUseCaseImpl) GetRatings(ctx context.Context) ([]entity.TeamRating, error) {
+	return uc.ratingRepo.FindAll(ctx)
+}
+
+func (uc *ratingUseCaseImpl) GetHistory(ctx context.Context, teamID uuid.UUID) (*TeamRatingHistory, error) {
+	team, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	history, err := uc.ratingRepo.FindHistoryByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &TeamRatingHistory{Team: team, Rating: uc.settings.InitialRating, History: history}
+	if len(history) > 0 {
+		record.Rating = history[len(history)-1].RatingAfter
+	}
+	return record, nil
+}
+
+// ratedBefore reports whether a is replayed before b. Matches are rated in
+// kickoff order, and those kicking off together by ID, as FindAllCompleted
+// returns them.
+func ratedBefore(a, b *entity.Match) bool {
+	if a.KicksOffBefore(b) || b.KicksOffBefore(a) {
+		return a.KicksOffBefore(b)
+	}
+	return bytes.Compare(a.ID[:], b.ID[:]) < 0
+}
+
+// rate applies a completed match to both teams' ratings, which must already
+// be in the map, and returns the history entries it produced
+func (uc *ratingUseCaseImpl) rate(match *entity.Match, ratings map[uuid.UUID]*entity.TeamRating) []entity.RatingHistory {
+	if match.HomeScore == nil || match.AwayScore == nil {
+		return nil
+	}
+
+	home, away := ratings[match.HomeTeamID], ratings[match.AwayTeamID]
+	change := uc.settings.change(home.Rating, away.Rating, *match.HomeScore, *match.AwayScore)
+
+	history := []entity.RatingHistory{
+		{TeamID: home.TeamID, MatchID: match.ID, OpponentID: away.TeamID, RatingBefore: home.Rating, RatingAfter: home.Rating + change},
+		{TeamID: away.TeamID, MatchID: match.ID, OpponentID: home.TeamID, RatingBefore: away.Rating, RatingAfter: away.Rating - change},
+	}
+	home.Rating += change
+	away.Rating -= change
+	home.Matches++
+	away.Matches++
+	return history
+}
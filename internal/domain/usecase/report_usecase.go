@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -10,45 +11,88 @@ import (
 	"gorm.io/gorm"
 )
 
+var (
+	ErrHeadToHeadSameTeam = errors.New("head-to-head needs two different teams")
+)
+
+// headToHeadScorerLimit is the number of top scorers in a head-to-head record
+const headToHeadScorerLimit = 10
+
 // MatchReport represents a detailed match report
 type MatchReport struct {
-	Match               *entity.Match             `json:"match"`
-	HomeTeam            *entity.Team              `json:"home_team"`
-	AwayTeam            *entity.Team              `json:"away_team"`
-	HomeScore           int                       `json:"home_score"`
-	AwayScore           int                       `json:"away_score"`
-	MatchResult         string                    `json:"match_result"`
-	MatchResultDisplay  string                    `json:"match_result_display"`
-	Goals               []entity.Goal             `json:"goals"`
-	TopScorer           *repository.TopScorerResult `json:"top_scorer,omitempty"`
-	HomeTeamTotalWins   int64                     `json:"home_team_total_wins"`
-	AwayTeamTotalWins   int64                     `json:"away_team_total_wins"`
+	Match              *entity.Match               `json:"match"`
+	HomeTeam           *entity.Team                `json:"home_team"`
+	AwayTeam           *entity.Team                `json:"away_team"`
+	HomeScore          int                         `json:"home_score"`
+	AwayScore          int                         `json:"away_score"`
+	MatchResult        string                      `json:"match_result"`
+	MatchResultDisplay string                      `json:"match_result_display"`
+	Goals              []entity.Goal               `json:"goals"`
+	Events             []entity.MatchEvent         `json:"events"`
+	TopScorer          *repository.TopScorerResult `json:"top_scorer,omitempty"`
+	HomeTeamTotalWins  int64                       `json:"home_team_total_wins"`
+	AwayTeamTotalWins  int64                       `json:"away_team_total_wins"`
+}
+
+// MatchReportPage represents a page of match reports
+type MatchReportPage struct {
+	Reports   []MatchReport
+	Total     int64
+	TopScorer *repository.TopScorerResult // Across every match in the filter
 }
 
 // LeaderboardEntry represents a team's standings
 type LeaderboardEntry struct {
-	Team        *entity.Team `json:"team"`
-	Played      int64        `json:"played"`
-	Won         int64        `json:"won"`
-	Drawn       int64        `json:"drawn"`
-	Lost        int64        `json:"lost"`
-	GoalsFor    int64        `json:"goals_for"`
-	GoalsAgainst int64       `json:"goals_against"`
-	GoalDiff    int64        `json:"goal_difference"`
-	Points      int64        `json:"points"`
+	Position     int          `json:"position"`
+	TeamID       uuid.UUID    `json:"team_id"`
+	Team         *entity.Team `json:"team"`
+	Played       int64        `json:"played"`
+	Won          int64        `json:"won"`
+	Drawn        int64        `json:"drawn"`
+	Lost         int64        `json:"lost"`
+	GoalsFor     int64        `json:"goals_for"`
+	GoalsAgainst int64        `json:"goals_against"`
+	GoalDiff     int64        `json:"goal_difference"`
+	Points       int64        `json:"points"`
+}
+
+// HeadToHead represents the record of two teams against each other. Matches
+// decided on penalties count as draws.
+type HeadToHead struct {
+	TeamA         *entity.Team
+	TeamB         *entity.Team
+	Matches       []entity.Match // Most recent first
+	TeamAWins     int
+	TeamBWins     int
+	Draws         int
+	TeamAGoals    int
+	TeamBGoals    int
+	LargestResult *TeamMatchResult // From team A's point of view
+	TopScorers    []HeadToHeadScorer
+}
+
+// HeadToHeadScorer represents a player's goals in a fixture
+type HeadToHeadScorer struct {
+	PlayerID uuid.UUID
+	Player   *entity.Player
+	TeamID   uuid.UUID
+	Goals    int
 }
 
 // ReportUseCase defines the interface for report operations
 type ReportUseCase interface {
 	GetMatchReport(ctx context.Context, matchID uuid.UUID) (*MatchReport, error)
-	GetAllMatchReports(ctx context.Context, page, limit int) ([]MatchReport, int64, error)
-	GetTopScorers(ctx context.Context, limit int) ([]repository.TopScorerResult, error)
+	GetAllMatchReports(ctx context.Context, seasonID *uuid.UUID, page, limit int) (*MatchReportPage, error)
+	GetTopScorers(ctx context.Context, seasonID *uuid.UUID, limit int) ([]repository.TopScorerResult, error)
+	GetStandings(ctx context.Context, seasonID *uuid.UUID, points PointsSystem) ([]LeaderboardEntry, error)
+	GetHeadToHead(ctx context.Context, teamA, teamB uuid.UUID) (*HeadToHead, error)
 }
 
 type reportUseCaseImpl struct {
-	matchRepo repository.MatchRepository
-	goalRepo  repository.GoalRepository
-	teamRepo  repository.TeamRepository
+	matchRepo  repository.MatchRepository
+	goalRepo   repository.GoalRepository
+	teamRepo   repository.TeamRepository
+	seasonRepo repository.SeasonRepository
 }
 
 // NewReportUseCase creates a new instance of ReportUseCase
@@ -56,11 +100,13 @@ func NewReportUseCase(
 	matchRepo repository.MatchRepository,
 	goalRepo repository.GoalRepository,
 	teamRepo repository.TeamRepository,
+	seasonRepo repository.SeasonRepository,
 ) ReportUseCase {
 	return &reportUseCaseImpl{
-		matchRepo: matchRepo,
-		goalRepo:  goalRepo,
-		teamRepo:  teamRepo,
+		matchRepo:  matchRepo,
+		goalRepo:   goalRepo,
+		teamRepo:   teamRepo,
+		seasonRepo: seasonRepo,
 	}
 }
 
@@ -74,41 +120,172 @@ func (uc *reportUseCaseImpl) GetMatchReport(ctx context.Context, matchID uuid.UU
 		return nil, err
 	}
 
-	// Get home team total wins
-	homeWins, err := uc.matchRepo.GetTeamWinCount(ctx, match.HomeTeamID, true)
+	// Get both teams' total wins, home and away
+	wins, err := uc.matchRepo.CountWinsByTeams(ctx, []uuid.UUID{match.HomeTeamID, match.AwayTeamID})
 	if err != nil {
 		return nil, err
 	}
 
-	// Also count away wins for home team
-	homeAwayWins, err := uc.matchRepo.GetTeamWinCount(ctx, match.HomeTeamID, false)
+	// Get top scorer within the match's season, or overall when unscoped
+	topScorers, err := uc.goalRepo.GetTopScorers(ctx, match.SeasonID, 1)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get away team total wins
-	awayWins, err := uc.matchRepo.GetTeamWinCount(ctx, match.AwayTeamID, false)
+	report := newMatchReport(match, wins)
+	if len(topScorers) > 0 {
+		report.TopScorer = &topScorers[0]
+	}
+
+	return &report, nil
+}
+
+func (uc *reportUseCaseImpl) GetAllMatchReports(ctx context.Context, seasonID *uuid.UUID, page, limit int) (*MatchReportPage, error) {
+	if err := uc.ensureSeasonExists(ctx, seasonID); err != nil {
+		return nil, err
+	}
+
+	matches, total, err := uc.matchRepo.GetCompletedMatches(ctx, seasonID, page, limit)
 	if err != nil {
 		return nil, err
 	}
 
-	// Also count home wins for away team
-	awayHomeWins, err := uc.matchRepo.GetTeamWinCount(ctx, match.AwayTeamID, true)
+	// Count wins for every team on the page at once
+	seen := make(map[uuid.UUID]bool)
+	var teamIDs []uuid.UUID
+	for _, match := range matches {
+		for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+			if !seen[teamID] {
+				seen[teamID] = true
+				teamIDs = append(teamIDs, teamID)
+			}
+		}
+	}
+	wins, err := uc.matchRepo.CountWinsByTeams(ctx, teamIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get top scorer
-	topScorers, err := uc.goalRepo.GetTopScorers(ctx, 1)
+	// Get top scorer across all matches in the filter, not just this page
+	topScorers, err := uc.goalRepo.GetTopScorers(ctx, seasonID, 1)
 	if err != nil {
 		return nil, err
 	}
 
-	var topScorer *repository.TopScorerResult
+	result := &MatchReportPage{
+		Reports: make([]MatchReport, len(matches)),
+		Total:   total,
+	}
+	for i := range matches {
+		result.Reports[i] = newMatchReport(&matches[i], wins)
+	}
 	if len(topScorers) > 0 {
-		topScorer = &topScorers[0]
+		result.TopScorer = &topScorers[0]
+	}
+
+	return result, nil
+}
+
+func (uc *reportUseCaseImpl) GetTopScorers(ctx context.Context, seasonID *uuid.UUID, limit int) ([]repository.TopScorerResult, error) {
+	if err := uc.ensureSeasonExists(ctx, seasonID); err != nil {
+		return nil, err
+	}
+	return uc.goalRepo.GetTopScorers(ctx, seasonID, limit)
+}
+
+func (uc *reportUseCaseImpl) GetStandings(ctx context.Context, seasonID *uuid.UUID, points PointsSystem) ([]LeaderboardEntry, error) {
+	if err := points.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ensureSeasonExists(ctx, seasonID); err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.FindAllCompleted(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ComputeStandings(matches, points), nil
+}
+
+// GetHeadToHead returns every completed meeting of the two teams with either
+// side at home
+func (uc *reportUseCaseImpl) GetHeadToHead(ctx context.Context, teamA, teamB uuid.UUID) (*HeadToHead, error) {
+	if teamA == teamB {
+		return nil, ErrHeadToHeadSameTeam
+	}
+
+	first, err := uc.findTeam(ctx, teamA)
+	if err != nil {
+		return nil, err
+	}
+	second, err := uc.findTeam(ctx, teamB)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.FindCompletedBetweenTeams(ctx, teamA, teamB)
+	if err != nil {
+		return nil, err
+	}
+	record := &HeadToHead{TeamA: first, TeamB: second, Matches: matches}
+	scorers := make(map[uuid.UUID]*HeadToHeadScorer)
+	for i := range matches {
+		result, ok := teamMatchResult(&matches[i], teamA)
+		if !ok {
+			continue
+		}
+
+		record.TeamAGoals += result.GoalsFor
+		record.TeamBGoals += result.GoalsAgainst
+		switch result.Outcome {
+		case OutcomeWin:
+			record.TeamAWins++
+		case OutcomeLoss:
+			record.TeamBWins++
+		default:
+			record.Draws++
+		}
+		if result.Outcome != OutcomeDraw && (record.LargestResult == nil || isBiggerResult(result, record.LargestResult)) {
+			record.LargestResult = result
+		}
+
+		for _, goal := range matches[i].Goals {
+			if goal.IsOwnGoal {
+				continue
+			}
+			scorer, ok := scorers[goal.PlayerID]
+			if !ok {
+				scorer = &HeadToHeadScorer{PlayerID: goal.PlayerID, Player: goal.Player, TeamID: goal.TeamID}
+				scorers[goal.PlayerID] = scorer
+			}
+			scorer.Goals++
+		}
+	}
+
+	record.TopScorers = make([]HeadToHeadScorer, 0, len(scorers))
+	for _, scorer := range scorers {
+		record.TopScorers = append(record.TopScorers, *scorer)
+	}
+	sort.Slice(record.TopScorers, func(i, j int) bool {
+		a, b := record.TopScorers[i], record.TopScorers[j]
+		if a.Goals != b.Goals {
+			return a.Goals > b.Goals
+		}
+		return playerName(a.Player) < playerName(b.Player)
+	})
+	if len(record.TopScorers) > headToHeadScorerLimit {
+		record.TopScorers = record.TopScorers[:headToHeadScorerLimit]
 	}
 
+	return record, nil
+}
+
+// newMatchReport builds the report of a match from its details and the teams'
+// win totals
+func newMatchReport(match *entity.Match, wins map[uuid.UUID]int64) MatchReport {
 	homeScore := 0
 	awayScore := 0
 	if match.HomeScore != nil {
@@ -118,7 +295,7 @@ func (uc *reportUseCaseImpl) GetMatchReport(ctx context.Context, matchID uuid.UU
 		awayScore = *match.AwayScore
 	}
 
-	report := &MatchReport{
+	return MatchReport{
 		Match:              match,
 		HomeTeam:           match.HomeTeam,
 		AwayTeam:           match.AwayTeam,
@@ -127,60 +304,35 @@ func (uc *reportUseCaseImpl) GetMatchReport(ctx context.Context, matchID uuid.UU
 		MatchResult:        string(match.GetResult()),
 		MatchResultDisplay: match.GetResultDisplay(),
 		Goals:              match.Goals,
-		TopScorer:          topScorer,
-		HomeTeamTotalWins:  homeWins + homeAwayWins,
-		AwayTeamTotalWins:  awayWins + awayHomeWins,
+		Events:             match.Events,
+		HomeTeamTotalWins:  wins[match.HomeTeamID],
+		AwayTeamTotalWins:  wins[match.AwayTeamID],
 	}
-
-	return report, nil
 }
 
-func (uc *reportUseCaseImpl) GetAllMatchReports(ctx context.Context, page, limit int) ([]MatchReport, int64, error) {
-	matches, total, err := uc.matchRepo.GetCompletedMatches(ctx, page, limit)
+// findTeam returns the team, mapping a missing record to ErrTeamNotFound
+func (uc *reportUseCaseImpl) findTeam(ctx context.Context, teamID uuid.UUID) (*entity.Team, error) {
+	team, err := uc.teamRepo.FindByID(ctx, teamID)
 	if err != nil {
-		return nil, 0, err
-	}
-
-	reports := make([]MatchReport, len(matches))
-	for i, match := range matches {
-		homeScore := 0
-		awayScore := 0
-		if match.HomeScore != nil {
-			homeScore = *match.HomeScore
-		}
-		if match.AwayScore != nil {
-			awayScore = *match.AwayScore
-		}
-
-		// Get team win counts
-		homeWins, _ := uc.matchRepo.GetTeamWinCount(ctx, match.HomeTeamID, true)
-		homeAwayWins, _ := uc.matchRepo.GetTeamWinCount(ctx, match.HomeTeamID, false)
-		awayWins, _ := uc.matchRepo.GetTeamWinCount(ctx, match.AwayTeamID, false)
-		awayHomeWins, _ := uc.matchRepo.GetTeamWinCount(ctx, match.AwayTeamID, true)
-
-		reports[i] = MatchReport{
-			Match:              &matches[i],
-			HomeTeam:           match.HomeTeam,
-			AwayTeam:           match.AwayTeam,
-			HomeScore:          homeScore,
-			AwayScore:          awayScore,
-			MatchResult:        string(match.GetResult()),
-			MatchResultDisplay: match.GetResultDisplay(),
-			Goals:              match.Goals,
-			HomeTeamTotalWins:  homeWins + homeAwayWins,
-			AwayTeamTotalWins:  awayWins + awayHomeWins,
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTeamNotFound
 		}
+		return nil, err
 	}
-
-	// Get top scorer for overall
-	topScorers, err := uc.goalRepo.GetTopScorers(ctx, 1)
-	if err == nil && len(topScorers) > 0 && len(reports) > 0 {
-		reports[0].TopScorer = &topScorers[0]
-	}
-
-	return reports, total, nil
+	return team, nil
 }
 
-func (uc *reportUseCaseImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.TopScorerResult, error) {
-	return uc.goalRepo.GetTopScorers(ctx, limit)
+// ensureSeasonExists validates the optional season filter
+func (uc *reportUseCaseImpl) ensureSeasonExists(ctx context.Context, seasonID *uuid.UUID) error {
+	if seasonID == nil {
+		return nil
+	}
+	exists, err := uc.seasonRepo.Exists(ctx, *seasonID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrSeasonNotFound
+	}
+	return nil
 }
@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+var (
+	ErrInvalidPointsSystem = errors.New("points must be non-negative and win >= draw >= loss")
+)
+
+// PointsSystem defines how many points a team earns per result
+type PointsSystem struct {
+	Win  int64
+	Draw int64
+	Loss int64
+}
+
+// DefaultPointsSystem returns the standard 3/1/0 points system
+func DefaultPointsSystem() PointsSystem {
+	return PointsSystem{Win: 3, Draw: 1, Loss: 0}
+}
+
+// Validate checks that the points system is consistent
+func (p PointsSystem) Validate() error {
+	if p.Win < 0 || p.Draw < 0 || p.Loss < 0 {
+		return ErrInvalidPointsSystem
+	}
+	if p.Win < p.Draw || p.Draw < p.Loss {
+		return ErrInvalidPointsSystem
+	}
+	return nil
+}
+
+// ComputeStandings builds a sorted league table from completed matches.
+// Ties are broken by points, goal difference, goals scored, the head-to-head
+// record between the tied teams and finally team name.
+func ComputeStandings(matches []entity.Match, points PointsSystem) []LeaderboardEntry {
+	return rank(tally(matches, points, nil), matches, points)
+}
+
+// ComputeTable builds a sorted table like ComputeStandings but always lists
+// every given team, including teams that have not completed a match yet.
+func ComputeTable(teams []entity.Team, matches []entity.Match, points PointsSystem) []LeaderboardEntry {
+	entries := tally(matches, points, nil)
+
+	listed := make(map[uuid.UUID]bool, len(entries))
+	for _, entry := range entries {
+		listed[entry.TeamID] = true
+	}
+	for i := range teams {
+		if !listed[teams[i].ID] {
+			entries = append(entries, LeaderboardEntry{TeamID: teams[i].ID, Team: &teams[i]})
+		}
+	}
+
+	return rank(entries, matches, points)
+}
+
+// rank sorts entries into table order and assigns their positions
+func rank(entries []LeaderboardEntry, matches []entity.Match, points PointsSystem) []LeaderboardEntry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return compareRecord(&entries[i], &entries[j]) < 0
+	})
+
+	// Resolve teams level on points, goal difference and goals scored
+	// using only the matches played between them.
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && compareRecord(&entries[start], &entries[end]) == 0 {
+			end++
+		}
+		if end-start > 1 {
+			breakTieByHeadToHead(entries[start:end], matches, points)
+		}
+		start = end
+	}
+
+	for i := range entries {
+		entries[i].Position = i + 1
+	}
+
+	return entries
+}
+
+// tally aggregates match results per team. When only is non-nil, matches
+// involving teams outside the set are ignored.
+func tally(matches []entity.Match, points PointsSystem, only map[uuid.UUID]bool) []LeaderboardEntry {
+	index := make(map[uuid.UUID]int)
+	var entries []LeaderboardEntry
+
+	entryFor := func(teamID uuid.UUID, team *entity.Team) *LeaderboardEntry {
+		i, ok := index[teamID]
+		if !ok {
+			i = len(entries)
+			index[teamID] = i
+			entries = append(entries, LeaderboardEntry{TeamID: teamID, Team: team})
+		}
+		if entries[i].Team == nil {
+			entries[i].Team = team
+		}
+		return &entries[i]
+	}
+
+	for _, match := range matches {
+		if match.Status != entity.MatchStatusCompleted || match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		if only != nil && (!only[match.HomeTeamID] || !only[match.AwayTeamID]) {
+			continue
+		}
+
+		homeScore := int64(*match.HomeScore)
+		awayScore := int64(*match.AwayScore)
+
+		home := entryFor(match.HomeTeamID, match.HomeTeam)
+		home.record(homeScore, awayScore, points)
+
+		away := entryFor(match.AwayTeamID, match.AwayTeam)
+		away.record(awayScore, homeScore, points)
+	}
+
+	return entries
+}
+
+// record adds a single result to the entry
+func (e *LeaderboardEntry) record(goalsFor, goalsAgainst int64, points PointsSystem) {
+	e.Played++
+	e.GoalsFor += goalsFor
+	e.GoalsAgainst += goalsAgainst
+	e.GoalDiff = e.GoalsFor - e.GoalsAgainst
+
+	switch {
+	case goalsFor > goalsAgainst:
+		e.Won++
+		e.Points += points.Win
+	case goalsFor < goalsAgainst:
+		e.Lost++
+		e.Points += points.Loss
+	default:
+		e.Drawn++
+		e.Points += points.Draw
+	}
+}
+
+// compareRecord orders entries by points, goal difference and goals scored.
+// It returns a negative number when a ranks above b.
+func compareRecord(a, b *LeaderboardEntry) int {
+	if a.Points != b.Points {
+		return int(b.Points - a.Points)
+	}
+	if a.GoalDiff != b.GoalDiff {
+		return int(b.GoalDiff - a.GoalDiff)
+	}
+	if a.GoalsFor != b.GoalsFor {
+		return int(b.GoalsFor - a.GoalsFor)
+	}
+	return 0
+}
+
+// breakTieByHeadToHead reorders tied entries in place using a mini-league of
+// the matches played between them, falling back to team name.
+func breakTieByHeadToHead(tied []LeaderboardEntry, matches []entity.Match, points PointsSystem) {
+	teams := make(map[uuid.UUID]bool, len(tied))
+	for _, entry := range tied {
+		teams[entry.TeamID] = true
+	}
+
+	headToHead := make(map[uuid.UUID]LeaderboardEntry, len(tied))
+	for _, entry := range tally(matches, points, teams) {
+		headToHead[entry.TeamID] = entry
+	}
+
+	sort.SliceStable(tied, func(i, j int) bool {
+		a := headToHead[tied[i].TeamID]
+		b := headToHead[tied[j].TeamID]
+		if cmp := compareRecord(&a, &b); cmp != 0 {
+			return cmp < 0
+		}
+		return strings.ToLower(teamName(&tied[i])) < strings.ToLower(teamName(&tied[j]))
+	})
+}
+
+// teamName returns the entry's team name, or its ID when the team is not loaded
+func teamName(entry *LeaderboardEntry) string {
+	if entry.Team != nil {
+		return entry.Team.Name
+	}
+	return entry.TeamID.String()
+}
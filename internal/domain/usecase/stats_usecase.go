@@ -0,0 +1,420 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidDateRange = errors.New("start date cannot be after end date")
+)
+
+// minuteBucketSize is the width of each goal-minute bucket
+const minuteBucketSize = 15
+
+// minuteBucketCount covers 90 minutes, with one more bucket for extra time
+const minuteBucketCount = 90/minuteBucketSize + 1
+
+// formLength is the number of recent matches in a team's form guide
+const formLength = 5
+
+// MatchOutcome represents a match result from one team's point of view
+type MatchOutcome string
+
+const (
+	OutcomeWin  MatchOutcome = "W"
+	OutcomeDraw MatchOutcome = "D"
+	OutcomeLoss MatchOutcome = "L"
+)
+
+// DateRange limits statistics to matches played between two dates,
+// inclusive. Either end may be left open.
+type DateRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// Validate checks the date range
+func (r DateRange) Validate() error {
+	if r.From != nil && r.To != nil && r.From.After(*r.To) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
+
+// Contains checks if the date falls within the range
+func (r DateRange) Contains(date time.Time) bool {
+	if r.From != nil && date.Before(*r.From) {
+		return false
+	}
+	if r.To != nil && date.After(*r.To) {
+		return false
+	}
+	return true
+}
+
+// PlayerStats represents a player's scoring record
+type PlayerStats struct {
+	Player          *entity.Player
+	Range           DateRange
+	Appearances     int
+	Goals           int // Excludes own goals
+	PenaltyGoals    int
+	OwnGoals        int
+	GoalsPerMatch   float64
+	MatchesWithGoal int
+	FirstGoalDate   *time.Time
+	LastGoalDate    *time.Time
+	HomeGoals       int
+	AwayGoals       int
+	ByMinute        []MinuteBucket
+	ByOpponent      []OpponentGoals
+}
+
+// MinuteBucket represents the goals scored in a span of minutes. The last
+// bucket holds extra time.
+type MinuteBucket struct {
+	From  int
+	To    int // Zero for the open-ended extra time bucket
+	Goals int
+}
+
+// Label returns the bucket's span, such as 16-30 or 91+
+func (b MinuteBucket) Label() string {
+	if b.To == 0 {
+		return strconv.Itoa(b.From) + "+"
+	}
+	return strconv.Itoa(b.From) + "-" + strconv.Itoa(b.To)
+}
+
+// OpponentGoals represents the goals a player scored against one team
+type OpponentGoals struct {
+	Team  *entity.Team
+	Goals int
+}
+
+// TeamStats represents a team's results. Matches decided on penalties count
+// as draws, as in the standings.
+type TeamStats struct {
+	Team        *entity.Team
+	Range       DateRange
+	Overall     TeamRecord
+	Home        TeamRecord
+	Away        TeamRecord
+	BiggestWin  *TeamMatchResult
+	BiggestLoss *TeamMatchResult
+	WinStreak   Streak
+	Unbeaten    Streak
+	LosingRun   Streak
+	Form        string // Outcomes of the last five matches, oldest first
+}
+
+// TeamRecord represents a team's results over a set of matches
+type TeamRecord struct {
+	Played        int
+	Won           int
+	Drawn         int
+	Lost          int
+	GoalsFor      int
+	GoalsAgainst  int
+	CleanSheets   int
+	FailedToScore int
+}
+
+// Streak represents a run of results, the one still going and the longest
+type Streak struct {
+	Current int
+	Longest int
+}
+
+// TeamMatchResult represents a completed match from one team's point of view
+type TeamMatchResult struct {
+	Match        *entity.Match
+	Opponent     *entity.Team
+	IsHome       bool
+	GoalsFor     int
+	GoalsAgainst int
+	Outcome      MatchOutcome
+}
+
+// StatsUseCase defines the interface for player and team statistics
+type StatsUseCase interface {
+	GetPlayerStats(ctx context.Context, playerID uuid.UUID, dateRange DateRange) (*PlayerStats, error)
+	GetTeamStats(ctx context.Context, teamID uuid.UUID, dateRange DateRange) (*TeamStats, error)
+}
+
+type statsUseCaseImpl struct {
+	playerRepo repository.PlayerRepository
+	teamRepo   repository.TeamRepository
+	goalRepo   repository.GoalRepository
+	matchRepo  repository.MatchRepository
+}
+
+// NewStatsUseCase creates a new instance of StatsUseCase
+func NewStatsUseCase(
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	goalRepo repository.GoalRepository,
+	matchRepo repository.MatchRepository,
+) StatsUseCase {
+	return &statsUseCaseImpl{
+		playerRepo: playerRepo,
+		teamRepo:   teamRepo,
+		goalRepo:   goalRepo,
+		matchRepo:  matchRepo,
+	}
+}
+
+// GetPlayerStats aggregates the player's goals in completed matches
+func (uc *statsUseCaseImpl) GetPlayerStats(ctx context.Context, playerID uuid.UUID, dateRange DateRange) (*PlayerStats, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, err
+	}
+
+	player, err := uc.playerRepo.FindByIDWithTeam(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+
+	goals, err := uc.goalRepo.FindByPlayerID(ctx, playerID, dateRange.From, dateRange.To)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := uc.matchRepo.FindPlayedByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &PlayerStats{
+		Player:   player,
+		Range:    dateRange,
+		ByMinute: make([]MinuteBucket, minuteBucketCount),
+	}
+	for i := range stats.ByMinute {
+		stats.ByMinute[i] = MinuteBucket{From: i*minuteBucketSize + 1, To: (i + 1) * minuteBucketSize}
+	}
+	stats.ByMinute[0].From = 0
+	stats.ByMinute[minuteBucketCount-1].To = 0
+
+	for _, match := range matches {
+		if dateRange.Contains(match.MatchDate) {
+			stats.Appearances++
+		}
+	}
+
+	scoredIn := make(map[uuid.UUID]bool)
+	opponents := make(map[uuid.UUID]*OpponentGoals)
+	for _, goal := range goals {
+		match := goal.Match
+		if match == nil || match.Status != entity.MatchStatusCompleted {
+			continue
+		}
+		if goal.IsOwnGoal {
+			stats.OwnGoals++
+			continue
+		}
+
+		stats.Goals++
+		if goal.IsPenalty {
+			stats.PenaltyGoals++
+		}
+		if stats.FirstGoalDate == nil {
+			stats.FirstGoalDate = &match.MatchDate
+		}
+		stats.LastGoalDate = &match.MatchDate
+		scoredIn[match.ID] = true
+
+		bucket := (goal.Minute - 1) / minuteBucketSize
+		if bucket >= minuteBucketCount {
+			bucket = minuteBucketCount - 1
+		}
+		stats.ByMinute[bucket].Goals++
+
+		opponent := match.AwayTeam
+		if goal.TeamID == match.HomeTeamID {
+			stats.HomeGoals++
+		} else {
+			stats.AwayGoals++
+			opponent = match.HomeTeam
+		}
+		if opponent == nil {
+			continue
+		}
+		if _, ok := opponents[opponent.ID]; !ok {
+			opponents[opponent.ID] = &OpponentGoals{Team: opponent}
+		}
+		opponents[opponent.ID].Goals++
+	}
+
+	stats.MatchesWithGoal = len(scoredIn)
+	if stats.Appearances > 0 {
+		stats.GoalsPerMatch = float64(stats.Goals) / float64(stats.Appearances)
+	}
+
+	stats.ByOpponent = make([]OpponentGoals, 0, len(opponents))
+	for _, opponent := range opponents {
+		stats.ByOpponent = append(stats.ByOpponent, *opponent)
+	}
+	sort.Slice(stats.ByOpponent, func(i, j int) bool {
+		a, b := stats.ByOpponent[i], stats.ByOpponent[j]
+		if a.Goals != b.Goals {
+			return a.Goals > b.Goals
+		}
+		return a.Team.Name < b.Team.Name
+	})
+
+	return stats, nil
+}
+
+// GetTeamStats aggregates the team's completed matches
+func (uc *statsUseCaseImpl) GetTeamStats(ctx context.Context, teamID uuid.UUID, dateRange DateRange) (*TeamStats, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, err
+	}
+
+	team, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.FindCompletedByTeam(ctx, teamID, dateRange.From, dateRange.To)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &TeamStats{Team: team, Range: dateRange}
+	var form []MatchOutcome
+	for i := range matches {
+		result, ok := teamMatchResult(&matches[i], teamID)
+		if !ok {
+			continue
+		}
+
+		stats.Overall.add(result)
+		if result.IsHome {
+			stats.Home.add(result)
+		} else {
+			stats.Away.add(result)
+		}
+
+		stats.WinStreak.extend(result.Outcome == OutcomeWin)
+		stats.Unbeaten.extend(result.Outcome != OutcomeLoss)
+		stats.LosingRun.extend(result.Outcome == OutcomeLoss)
+
+		margin := result.GoalsFor - result.GoalsAgainst
+		switch {
+		case margin > 0 && (stats.BiggestWin == nil || isBiggerResult(result, stats.BiggestWin)):
+			stats.BiggestWin = result
+		case margin < 0 && (stats.BiggestLoss == nil || isBiggerResult(result, stats.BiggestLoss)):
+			stats.BiggestLoss = result
+		}
+
+		form = append(form, result.Outcome)
+	}
+
+	if len(form) > formLength {
+		form = form[len(form)-formLength:]
+	}
+	for _, outcome := range form {
+		stats.Form += string(outcome)
+	}
+
+	return stats, nil
+}
+
+// teamMatchResult returns a completed match from the team's point of view
+func teamMatchResult(match *entity.Match, teamID uuid.UUID) (*TeamMatchResult, bool) {
+	if match.HomeScore == nil || match.AwayScore == nil {
+		return nil, false
+	}
+
+	result := &TeamMatchResult{Match: match}
+	switch teamID {
+	case match.HomeTeamID:
+		result.IsHome = true
+		result.Opponent = match.AwayTeam
+		result.GoalsFor, result.GoalsAgainst = *match.HomeScore, *match.AwayScore
+	case match.AwayTeamID:
+		result.Opponent = match.HomeTeam
+		result.GoalsFor, result.GoalsAgainst = *match.AwayScore, *match.HomeScore
+	default:
+		return nil, false
+	}
+
+	switch {
+	case result.GoalsFor > result.GoalsAgainst:
+		result.Outcome = OutcomeWin
+	case result.GoalsFor < result.GoalsAgainst:
+		result.Outcome = OutcomeLoss
+	default:
+		result.Outcome = OutcomeDraw
+	}
+	return result, true
+}
+
+// add counts a result towards the record
+func (r *TeamRecord) add(result *TeamMatchResult) {
+	r.Played++
+	r.GoalsFor += result.GoalsFor
+	r.GoalsAgainst += result.GoalsAgainst
+	if result.GoalsAgainst == 0 {
+		r.CleanSheets++
+	}
+	if result.GoalsFor == 0 {
+		r.FailedToScore++
+	}
+
+	switch result.Outcome {
+	case OutcomeWin:
+		r.Won++
+	case OutcomeLoss:
+		r.Lost++
+	default:
+		r.Drawn++
+	}
+}
+
+// extend continues the current run, or ends it when the result breaks it
+func (s *Streak) extend(continues bool) {
+	if !continues {
+		s.Current = 0
+		return
+	}
+	s.Current++
+	if s.Current > s.Longest {
+		s.Longest = s.Current
+	}
+}
+
+// isBiggerResult checks if a result has a wider margin than another, or the
+// same margin with more goals
+func isBiggerResult(a, b *TeamMatchResult) bool {
+	marginA := abs(a.GoalsFor - a.GoalsAgainst)
+	marginB := abs(b.GoalsFor - b.GoalsAgainst)
+	if marginA != marginB {
+		return marginA > marginB
+	}
+	return a.GoalsFor+a.GoalsAgainst > b.GoalsFor+b.GoalsAgainst
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
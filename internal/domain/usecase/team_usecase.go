@@ -26,15 +26,19 @@ type TeamUseCase interface {
 }
 
 type teamUseCaseImpl struct {
-	teamRepo repository.TeamRepository
+	teamRepo  repository.TeamRepository
+	venueRepo repository.VenueRepository
 }
 
 // NewTeamUseCase creates a new instance of TeamUseCase
-func NewTeamUseCase(teamRepo repository.TeamRepository) TeamUseCase {
-	return &teamUseCaseImpl{teamRepo: teamRepo}
+func NewTeamUseCase(teamRepo repository.TeamRepository, venueRepo repository.VenueRepository) TeamUseCase {
+	return &teamUseCaseImpl{teamRepo: teamRepo, venueRepo: venueRepo}
 }
 
 func (uc *teamUseCaseImpl) Create(ctx context.Context, team *entity.Team) error {
+	if err := validateVenue(ctx, uc.venueRepo, team.HomeVenueID); err != nil {
+		return err
+	}
 	return uc.teamRepo.Create(ctx, team)
 }
 
@@ -68,6 +72,9 @@ func (uc *teamUseCaseImpl) Update(ctx context.Context, team *entity.Team) error
 	if !exists {
 		return ErrTeamNotFound
 	}
+	if err := validateVenue(ctx, uc.venueRepo, team.HomeVenueID); err != nil {
+		return err
+	}
 	return uc.teamRepo.Update(ctx, team)
 }
 
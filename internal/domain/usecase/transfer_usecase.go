@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTransferToSameTeam     = errors.New("player already plays for that team")
+	ErrTransferDateInFuture   = errors.New("transfer date cannot be in the future")
+	ErrTransferDateBeforeLast = errors.New("transfer date cannot be before the player's previous transfer")
+)
+
+// TransferInput represents the input for transferring a player
+type TransferInput struct {
+	ToTeamID     uuid.UUID
+	TransferDate time.Time
+	Fee          float64
+	IsLoan       bool
+	JerseyNumber int // Keeps the current number when zero
+}
+
+// PlayerCareer represents the teams a player has played for
+type PlayerCareer struct {
+	Player      *entity.Player
+	Stints      []PlayerStint
+	Transfers   []entity.Transfer
+	Appearances int
+	Goals       int
+}
+
+// PlayerStint represents a period a player spent at one team. The first
+// stint has no start date when the player joined before their first
+// transfer; the current stint has no end date.
+type PlayerStint struct {
+	Team        *entity.Team
+	From        *time.Time
+	Until       *time.Time
+	Fee         float64
+	IsLoan      bool
+	Appearances int
+	Goals       int
+}
+
+// TransferUseCase defines the interface for player transfer operations
+type TransferUseCase interface {
+	Transfer(ctx context.Context, playerID uuid.UUID, input TransferInput) (*entity.Transfer, error)
+	GetCareer(ctx context.Context, playerID uuid.UUID) (*PlayerCareer, error)
+}
+
+type transferUseCaseImpl struct {
+	transferRepo repository.TransferRepository
+	playerRepo   repository.PlayerRepository
+	teamRepo     repository.TeamRepository
+	matchRepo    repository.MatchRepository
+	transactor   repository.Transactor
+	now          func() time.Time
+}
+
+// NewTransferUseCase creates a new instance of TransferUseCase
+func NewTransferUseCase(
+	transferRepo repository.TransferRepository,
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	matchRepo repository.MatchRepository,
+	transactor repository.Transactor,
+) TransferUseCase {
+	return &transferUseCaseImpl{
+		transferRepo: transferRepo,
+		playerRepo:   playerRepo,
+		teamRepo:     teamRepo,
+		matchRepo:    matchRepo,
+		transactor:   transactor,
+		now:          time.Now,
+	}
+}
+
+// Transfer moves the player to another team, recording the move and the
+// player's new team together
+func (uc *transferUseCaseImpl) Transfer(ctx context.Context, playerID uuid.UUID, input TransferInput) (*entity.Transfer, error) {
+	player, err := uc.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+
+	exists, err := uc.teamRepo.Exists(ctx, input.ToTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+	if input.ToTeamID == player.TeamID {
+		return nil, ErrTransferToSameTeam
+	}
+
+	if input.TransferDate.After(uc.now()) {
+		return nil, ErrTransferDateInFuture
+	}
+	transfers, err := uc.transferRepo.FindByPlayerID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(transfers) > 0 && input.TransferDate.Before(transfers[len(transfers)-1].TransferDate) {
+		return nil, ErrTransferDateBeforeLast
+	}
+
+	if input.JerseyNumber != 0 {
+		if input.JerseyNumber < 1 || input.JerseyNumber > 99 {
+			return nil, ErrInvalidJerseyNumber
+		}
+		player.JerseyNumber = input.JerseyNumber
+	}
+	taken, err := uc.playerRepo.IsJerseyNumberTaken(ctx, input.ToTeamID, player.JerseyNumber, &player.ID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, ErrJerseyNumberTaken
+	}
+
+	transfer := &entity.Transfer{
+		PlayerID:     player.ID,
+		FromTeamID:   player.TeamID,
+		ToTeamID:     input.ToTeamID,
+		TransferDate: input.TransferDate,
+		Fee:          input.Fee,
+		IsLoan:       input.IsLoan,
+	}
+	player.TeamID = input.ToTeamID
+	player.Team = nil
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.transferRepo.Create(ctx, transfer); err != nil {
+			return err
+		}
+		return uc.playerRepo.Update(ctx, player)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// GetCareer returns the player's stints at each team with the appearances
+// made and goals scored during each
+func (uc *transferUseCaseImpl) GetCareer(ctx context.Context, playerID uuid.UUID) (*PlayerCareer, error) {
+	player, err := uc.playerRepo.FindByIDWithTeam(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+
+	transfers, err := uc.transferRepo.FindByPlayerID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.matchRepo.FindPlayedByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	career := &PlayerCareer{
+		Player:    player,
+		Stints:    buildStints(player, transfers),
+		Transfers: transfers,
+	}
+
+	for _, match := range matches {
+		stint := findStint(career.Stints, &match)
+		if stint == nil {
+			continue
+		}
+
+		goals := 0
+		for _, goal := range match.Goals {
+			if goal.PlayerID == playerID && !goal.IsOwnGoal {
+				goals++
+			}
+		}
+
+		stint.Appearances++
+		stint.Goals += goals
+		career.Appearances++
+		career.Goals += goals
+	}
+
+	return career, nil
+}
+
+// buildStints splits the player's career at each transfer
+func buildStints(player *entity.Player, transfers []entity.Transfer) []PlayerStint {
+	if len(transfers) == 0 {
+		return []PlayerStint{{Team: player.Team}}
+	}
+
+	stints := make([]PlayerStint, 0, len(transfers)+1)
+	stints = append(stints, PlayerStint{Team: transfers[0].FromTeam})
+	for i := range transfers {
+		transfer := &transfers[i]
+		stints[len(stints)-1].Until = &transfer.TransferDate
+		stints = append(stints, PlayerStint{
+			Team:   transfer.ToTeam,
+			From:   &transfer.TransferDate,
+			Fee:    transfer.Fee,
+			IsLoan: transfer.IsLoan,
+		})
+	}
+	return stints
+}
+
+// findPlayerTeamOn loads the player and the team they played for on date.
+// The earliest transfer after the date moved the player on from that team;
+// without one it is their current team. A transfer on the date itself counts,
+// as it does for the career stints.
+func findPlayerTeamOn(ctx context.Context, playerRepo repository.PlayerRepository, transferRepo repository.TransferRepository, playerID uuid.UUID, date time.Time) (*entity.Player, uuid.UUID, error) {
+	player, err := playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, uuid.Nil, ErrPlayerNotFound
+		}
+		return nil, uuid.Nil, err
+	}
+
+	transfers, err := transferRepo.FindByPlayerID(ctx, playerID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	for _, transfer := range transfers {
+		if transfer.TransferDate.After(date) {
+			return player, transfer.FromTeamID, nil
+		}
+	}
+	return player, player.TeamID, nil
+}
+
+// findStint returns the latest stint whose team played in the match during
+// the stint
+func findStint(stints []PlayerStint, match *entity.Match) *PlayerStint {
+	for i := len(stints) - 1; i >= 0; i-- {
+		stint := &stints[i]
+		if stint.Team == nil || (stint.Team.ID != match.HomeTeamID && stint.Team.ID != match.AwayTeamID) {
+			continue
+		}
+		if stint.From != nil && match.MatchDate.Before(*stint.From) {
+			continue
+		}
+		if stint.Until != nil && match.MatchDate.After(*stint.Until) {
+			continue
+		}
+		return stint
+	}
+	return nil
+}
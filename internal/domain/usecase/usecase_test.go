@@ -0,0 +1,149 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/usecase"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/pubsub"
+	"gorm.io/gorm"
+)
+
+// harness wires use cases to in-memory repositories sharing one store
+type harness struct {
+	store      *memory.Store
+	teams      repository.TeamRepository
+	players    repository.PlayerRepository
+	matches    repository.MatchRepository
+	goals      repository.GoalRepository
+	transfers  *transferLog
+	ratings    *ratingRebuilds
+	brackets   repository.BracketRepository
+	transactor repository.Transactor
+}
+
+func newHarness() *harness {
+	store := memory.NewStore()
+	return &harness{
+		store:      store,
+		teams:      memory.NewTeamRepository(store),
+		players:    memory.NewPlayerRepository(store),
+		matches:    memory.NewMatchRepository(store),
+		goals:      memory.NewGoalRepository(store),
+		transfers:  &transferLog{},
+		ratings:    &ratingRebuilds{},
+		brackets:   knockoutTies{},
+		transactor: memory.NewTransactor(store),
+	}
+}
+
+// matchUseCase creates a MatchUseCase for matches without a season or venue
+func (h *harness) matchUseCase(scheduling usecase.SchedulingRules, observers ...usecase.MatchResultObserver) usecase.MatchUseCase {
+	return usecase.NewMatchUseCase(
+		h.matches,
+		h.teams,
+		h.players,
+		h.transfers,
+		h.goals,
+		nil,
+		h.brackets,
+		noLineups{},
+		nil,
+		noSuspensions{},
+		h.transactor,
+		newMatchFeed(),
+		scheduling,
+		h.ratings,
+		observers...,
+	)
+}
+
+// newMatchFeed creates a MatchFeed on an in-memory broker
+func newMatchFeed() usecase.MatchFeed {
+	broker := pubsub.NewMemoryBroker()
+	return usecase.NewMatchFeed(broker, broker)
+}
+
+func (h *harness) playerUseCase() usecase.PlayerUseCase {
+	return usecase.NewPlayerUseCase(h.players, h.teams, nil, h.transactor)
+}
+
+// noLineups is a LineupRepository without lineups, so any player of a team
+// may score
+type noLineups struct {
+	repository.LineupRepository
+}
+
+func (noLineups) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Lineup, error) {
+	return nil, nil
+}
+
+// transferLog is a TransferRepository holding the transfers recorded with
+// record, in transfer date order
+type transferLog struct {
+	repository.TransferRepository
+	transfers []entity.Transfer
+}
+
+func (l *transferLog) record(player *entity.Player, to *entity.Team, date time.Time) {
+	l.transfers = append(l.transfers, entity.Transfer{
+		BaseEntity:   entity.BaseEntity{ID: uuid.New()},
+		PlayerID:     player.ID,
+		FromTeamID:   player.TeamID,
+		ToTeamID:     to.ID,
+		TransferDate: date,
+	})
+}
+
+func (l *transferLog) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Transfer, error) {
+	var transfers []entity.Transfer
+	for _, transfer := range l.transfers {
+		if transfer.PlayerID == playerID {
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers, nil
+}
+
+// ratingRebuilds is a RatingUseCase counting how often ratings are rebuilt
+type ratingRebuilds struct {
+	usecase.RatingUseCase
+	count int
+}
+
+func (r *ratingRebuilds) Rebuild(ctx context.Context) error {
+	r.count++
+	return nil
+}
+
+// knockoutTies is a BracketRepository holding knockout ties by match. Other
+// matches are not knockout ties.
+type knockoutTies struct {
+	repository.BracketRepository
+	ties map[uuid.UUID]*entity.BracketTie
+}
+
+func (t knockoutTies) FindTieByMatchID(ctx context.Context, matchID uuid.UUID) (*entity.BracketTie, error) {
+	if tie, ok := t.ties[matchID]; ok {
+		return tie, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// noSuspensions is a DisciplineUseCase where every player is eligible
+type noSuspensions struct {
+	usecase.DisciplineUseCase
+}
+
+func (noSuspensions) CheckEligibility(ctx context.Context, match *entity.Match, playerIDs []uuid.UUID) error {
+	return nil
+}
+
+// kickoff returns 15:00 UTC on a day of January 2025
+func kickoff(day int) time.Time {
+	return time.Date(2025, time.January, day, 15, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrVenueNotFound       = errors.New("venue not found")
+	ErrInvalidVenueSurface = errors.New("venue surface must be grass, artificial or hybrid")
+)
+
+// VenueUseCase defines the interface for venue operations
+type VenueUseCase interface {
+	Create(ctx context.Context, venue *entity.Venue) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error)
+	Update(ctx context.Context, venue *entity.Venue) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetAll(ctx context.Context, page, limit int) ([]entity.Venue, int64, error)
+}
+
+type venueUseCaseImpl struct {
+	venueRepo repository.VenueRepository
+}
+
+// NewVenueUseCase creates a new instance of VenueUseCase
+func NewVenueUseCase(venueRepo repository.VenueRepository) VenueUseCase {
+	return &venueUseCaseImpl{venueRepo: venueRepo}
+}
+
+func (uc *venueUseCaseImpl) Create(ctx context.Context, venue *entity.Venue) error {
+	if venue.Surface == "" {
+		venue.Surface = entity.VenueSurfaceGrass
+	}
+	if !entity.IsValidVenueSurface(venue.Surface) {
+		return ErrInvalidVenueSurface
+	}
+	return uc.venueRepo.Create(ctx, venue)
+}
+
+func (uc *venueUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error) {
+	venue, err := uc.venueRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVenueNotFound
+		}
+		return nil, err
+	}
+	return venue, nil
+}
+
+func (uc *venueUseCaseImpl) Update(ctx context.Context, venue *entity.Venue) error {
+	exists, err := uc.venueRepo.Exists(ctx, venue.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrVenueNotFound
+	}
+	if !entity.IsValidVenueSurface(venue.Surface) {
+		return ErrInvalidVenueSurface
+	}
+	return uc.venueRepo.Update(ctx, venue)
+}
+
+func (uc *venueUseCaseImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	exists, err := uc.venueRepo.Exists(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrVenueNotFound
+	}
+	return uc.venueRepo.Delete(ctx, id)
+}
+
+func (uc *venueUseCaseImpl) GetAll(ctx context.Context, page, limit int) ([]entity.Venue, int64, error) {
+	return uc.venueRepo.FindAll(ctx, page, limit)
+}
+
+// validateVenue checks that a venue exists when one is set
+func validateVenue(ctx context.Context, venueRepo repository.VenueRepository, venueID *uuid.UUID) error {
+	if venueID == nil {
+		return nil
+	}
+	exists, err := venueRepo.Exists(ctx, *venueID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrVenueNotFound
+	}
+	return nil
+}
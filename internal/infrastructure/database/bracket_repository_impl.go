@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type bracketRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBracketRepository creates a new instance of BracketRepository
+func NewBracketRepository(db *gorm.DB) repository.BracketRepository {
+	return &bracketRepositoryImpl{db: db}
+}
+
+func (r *bracketRepositoryImpl) Create(ctx context.Context, bracket *entity.Bracket) error {
+	return getDB(ctx, r.db).Create(bracket).Error
+}
+
+func (r *bracketRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Bracket, error) {
+	var bracket entity.Bracket
+	err := getDB(ctx, r.db).First(&bracket, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &bracket, nil
+}
+
+func (r *bracketRepositoryImpl) FindByIDWithTies(ctx context.Context, id uuid.UUID) (*entity.Bracket, error) {
+	var bracket entity.Bracket
+	err := getDB(ctx, r.db).
+		Preload("Ties", func(db *gorm.DB) *gorm.DB {
+			return db.Order("round ASC, slot ASC")
+		}).
+		Preload("Ties.HomeTeam").
+		Preload("Ties.AwayTeam").
+		Preload("Ties.FirstLeg").
+		Preload("Ties.SecondLeg").
+		First(&bracket, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &bracket, nil
+}
+
+func (r *bracketRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entity.BracketTie{}, "bracket_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Bracket{}, "id = ?", id).Error
+	})
+}
+
+func (r *bracketRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Bracket, int64, error) {
+	var brackets []entity.Bracket
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := getDB(ctx, r.db).Model(&entity.Bracket{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = getDB(ctx, r.db).
+		Offset(offset).
+		Limit(limit).
+		Order("start_date DESC").
+		Find(&brackets).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return brackets, total, nil
+}
+
+func (r *bracketRepositoryImpl) ExistsBySeasonID(ctx context.Context, seasonID uuid.UUID) (bool, error) {
+	var count int64
+	err := getDB(ctx, r.db).
+		Model(&entity.Bracket{}).
+		Where("season_id = ?", seasonID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *bracketRepositoryImpl) FindTieByMatchID(ctx context.Context, matchID uuid.UUID) (*entity.BracketTie, error) {
+	var tie entity.BracketTie
+	err := getDB(ctx, r.db).
+		Preload("FirstLeg").
+		Preload("SecondLeg").
+		Where("first_leg_match_id = ? OR second_leg_match_id = ?", matchID, matchID).
+		First(&tie).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tie, nil
+}
+
+func (r *bracketRepositoryImpl) FindTie(ctx context.Context, bracketID uuid.UUID, round, slot int) (*entity.BracketTie, error) {
+	var tie entity.BracketTie
+	err := getDB(ctx, r.db).
+		Preload("FirstLeg").
+		Preload("SecondLeg").
+		Where("bracket_id = ? AND round = ? AND slot = ?", bracketID, round, slot).
+		First(&tie).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tie, nil
+}
+
+func (r *bracketRepositoryImpl) UpdateTie(ctx context.Context, tie *entity.BracketTie) error {
+	return getDB(ctx, r.db).Omit("HomeTeam", "AwayTeam", "FirstLeg", "SecondLeg").Save(tie).Error
+}
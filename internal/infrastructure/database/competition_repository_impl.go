@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type competitionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCompetitionRepository creates a new instance of CompetitionRepository
+func NewCompetitionRepository(db *gorm.DB) repository.CompetitionRepository {
+	return &competitionRepositoryImpl{db: db}
+}
+
+func (r *competitionRepositoryImpl) Create(ctx context.Context, competition *entity.Competition) error {
+	return getDB(ctx, r.db).Create(competition).Error
+}
+
+func (r *competitionRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Competition, error) {
+	var competition entity.Competition
+	err := getDB(ctx, r.db).First(&competition, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &competition, nil
+}
+
+func (r *competitionRepositoryImpl) FindByIDWithSeasons(ctx context.Context, id uuid.UUID) (*entity.Competition, error) {
+	var competition entity.Competition
+	err := getDB(ctx, r.db).
+		Preload("Seasons", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_date DESC")
+		}).
+		First(&competition, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &competition, nil
+}
+
+func (r *competitionRepositoryImpl) Update(ctx context.Context, competition *entity.Competition) error {
+	return getDB(ctx, r.db).Save(competition).Error
+}
+
+func (r *competitionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Delete(&entity.Competition{}, "id = ?", id).Error
+}
+
+func (r *competitionRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Competition, int64, error) {
+	var competitions []entity.Competition
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := getDB(ctx, r.db).Model(&entity.Competition{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = getDB(ctx, r.db).
+		Offset(offset).
+		Limit(limit).
+		Order("created_at DESC").
+		Find(&competitions).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return competitions, total, nil
+}
+
+func (r *competitionRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := getDB(ctx, r.db).
+		Model(&entity.Competition{}).
+		Where("id = ?", id).
+		Count(&count).Error
+	return count > 0, err
+}
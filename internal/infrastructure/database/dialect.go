@@ -0,0 +1,20 @@
+package database
+
+import "gorm.io/gorm"
+
+// Supported database drivers, matching DB_DRIVER and the GORM dialector names
+const (
+	DriverPostgres = "postgres"
+	DriverMySQL    = "mysql"
+	DriverSQLite   = "sqlite"
+)
+
+// containsInsensitive returns a condition matching column case-insensitively
+// against one LIKE pattern argument
+func containsInsensitive(db *gorm.DB, column string) string {
+	if db.Dialector.Name() == DriverPostgres {
+		return column + " ILIKE ?"
+	}
+	// MySQL and SQLite case sensitivity depends on collation, so fold both sides
+	return "LOWER(" + column + ") LIKE LOWER(?)"
+}
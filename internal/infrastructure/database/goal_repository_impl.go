@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -19,19 +20,19 @@ func NewGoalRepository(db *gorm.DB) repository.GoalRepository {
 }
 
 func (r *goalRepositoryImpl) Create(ctx context.Context, goal *entity.Goal) error {
-	return r.db.WithContext(ctx).Create(goal).Error
+	return getDB(ctx, r.db).Create(goal).Error
 }
 
 func (r *goalRepositoryImpl) CreateBatch(ctx context.Context, goals []entity.Goal) error {
 	if len(goals) == 0 {
 		return nil
 	}
-	return r.db.WithContext(ctx).Create(&goals).Error
+	return getDB(ctx, r.db).Create(&goals).Error
 }
 
 func (r *goalRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error) {
 	var goal entity.Goal
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Preload("Player").
 		Preload("Team").
 		First(&goal, "id = ?", id).Error
@@ -42,16 +43,16 @@ func (r *goalRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entit
 }
 
 func (r *goalRepositoryImpl) Update(ctx context.Context, goal *entity.Goal) error {
-	return r.db.WithContext(ctx).Save(goal).Error
+	return getDB(ctx, r.db).Save(goal).Error
 }
 
 func (r *goalRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Goal{}, "id = ?", id).Error
+	return getDB(ctx, r.db).Delete(&entity.Goal{}, "id = ?", id).Error
 }
 
 func (r *goalRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Goal, error) {
 	var goals []entity.Goal
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Preload("Player").
 		Preload("Team").
 		Where("match_id = ?", matchID).
@@ -60,32 +61,49 @@ func (r *goalRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUI
 	return goals, err
 }
 
-func (r *goalRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Goal, error) {
+func (r *goalRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID, from, to *time.Time) ([]entity.Goal, error) {
 	var goals []entity.Goal
-	err := r.db.WithContext(ctx).
-		Preload("Match").
+	query := getDB(ctx, r.db).
+		Preload("Match.HomeTeam").
+		Preload("Match.AwayTeam").
 		Preload("Team").
-		Where("player_id = ?", playerID).
-		Order("created_at DESC").
+		Joins("JOIN matches ON matches.id = goals.match_id AND matches.deleted_at IS NULL").
+		Where("goals.player_id = ?", playerID)
+	if from != nil {
+		query = query.Where("matches.match_date >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("matches.match_date <= ?", *to)
+	}
+	err := query.
+		Order("matches.match_date ASC, matches.match_time ASC, goals.minute ASC, goals.stoppage_time ASC").
 		Find(&goals).Error
 	return goals, err
 }
 
 func (r *goalRepositoryImpl) DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error {
-	return r.db.WithContext(ctx).
+	return getDB(ctx, r.db).
 		Where("match_id = ?", matchID).
 		Delete(&entity.Goal{}).Error
 }
 
-func (r *goalRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.TopScorerResult, error) {
+func (r *goalRepositoryImpl) GetTopScorers(ctx context.Context, seasonID *uuid.UUID, limit int) ([]repository.TopScorerResult, error) {
 	var results []repository.TopScorerResult
 
-	err := r.db.WithContext(ctx).
+	query := getDB(ctx, r.db).
 		Table("goals").
 		Select("goals.player_id, players.name as player_name, players.team_id, teams.name as team_name, COUNT(goals.id) as goal_count").
 		Joins("JOIN players ON players.id = goals.player_id AND players.deleted_at IS NULL").
 		Joins("JOIN teams ON teams.id = players.team_id AND teams.deleted_at IS NULL").
-		Where("goals.deleted_at IS NULL AND goals.is_own_goal = false").
+		Where("goals.deleted_at IS NULL AND goals.is_own_goal = false")
+
+	if seasonID != nil {
+		query = query.
+			Joins("JOIN matches ON matches.id = goals.match_id AND matches.deleted_at IS NULL").
+			Where("matches.season_id = ?", *seasonID)
+	}
+
+	err := query.
 		Group("goals.player_id, players.name, players.team_id, teams.name").
 		Order("goal_count DESC").
 		Limit(limit).
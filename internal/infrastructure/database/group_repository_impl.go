@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type groupRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewGroupRepository creates a new instance of GroupRepository
+func NewGroupRepository(db *gorm.DB) repository.GroupRepository {
+	return &groupRepositoryImpl{db: db}
+}
+
+func (r *groupRepositoryImpl) Create(ctx context.Context, group *entity.Group) error {
+	return getDB(ctx, r.db).Create(group).Error
+}
+
+func (r *groupRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Group, error) {
+	var group entity.Group
+	err := getDB(ctx, r.db).
+		Preload("Teams").
+		Preload("Teams.Team").
+		First(&group, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *groupRepositoryImpl) FindBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]entity.Group, error) {
+	var groups []entity.Group
+	err := getDB(ctx, r.db).
+		Preload("Teams").
+		Preload("Teams.Team").
+		Where("season_id = ?", seasonID).
+		Order("name ASC").
+		Find(&groups).Error
+	return groups, err
+}
+
+func (r *groupRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entity.GroupTeam{}, "group_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Group{}, "id = ?", id).Error
+	})
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type lineupRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewLineupRepository creates a new instance of LineupRepository
+func NewLineupRepository(db *gorm.DB) repository.LineupRepository {
+	return &lineupRepositoryImpl{db: db}
+}
+
+func (r *lineupRepositoryImpl) Create(ctx context.Context, lineup *entity.Lineup) error {
+	return getDB(ctx, r.db).Omit("Team", "Players.Player").Create(lineup).Error
+}
+
+func (r *lineupRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Lineup, error) {
+	var lineups []entity.Lineup
+	err := r.withPlayers(ctx).
+		Where("match_id = ?", matchID).
+		Order("created_at ASC").
+		Find(&lineups).Error
+	return lineups, err
+}
+
+func (r *lineupRepositoryImpl) FindByMatchAndTeam(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error) {
+	var lineup entity.Lineup
+	err := r.withPlayers(ctx).
+		First(&lineup, "match_id = ? AND team_id = ?", matchID, teamID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lineup, nil
+}
+
+func (r *lineupRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entity.LineupPlayer{}, "lineup_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Lineup{}, "id = ?", id).Error
+	})
+}
+
+// withPlayers preloads the team and the named players, starters first
+func (r *lineupRepositoryImpl) withPlayers(ctx context.Context) *gorm.DB {
+	return getDB(ctx, r.db).
+		Preload("Team").
+		Preload("Players", func(db *gorm.DB) *gorm.DB {
+			return db.Order("is_starter DESC, jersey_number ASC")
+		}).
+		Preload("Players.Player")
+}
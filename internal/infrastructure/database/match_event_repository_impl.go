@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type matchEventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewMatchEventRepository creates a new instance of MatchEventRepository
+func NewMatchEventRepository(db *gorm.DB) repository.MatchEventRepository {
+	return &matchEventRepositoryImpl{db: db}
+}
+
+func (r *matchEventRepositoryImpl) Create(ctx context.Context, event *entity.MatchEvent) error {
+	return getDB(ctx, r.db).Create(event).Error
+}
+
+func (r *matchEventRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.MatchEvent, error) {
+	var event entity.MatchEvent
+	err := getDB(ctx, r.db).
+		Preload("Player").
+		Preload("PlayerIn").
+		Preload("Team").
+		First(&event, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *matchEventRepositoryImpl) Update(ctx context.Context, event *entity.MatchEvent) error {
+	return getDB(ctx, r.db).Omit("Team", "Player", "PlayerIn").Save(event).Error
+}
+
+func (r *matchEventRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Delete(&entity.MatchEvent{}, "id = ?", id).Error
+}
+
+func (r *matchEventRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchEvent, error) {
+	var events []entity.MatchEvent
+	err := getDB(ctx, r.db).
+		Preload("Player").
+		Preload("PlayerIn").
+		Preload("Team").
+		Where("match_id = ?", matchID).
+		Order("minute ASC, stoppage_time ASC, created_at ASC").
+		Find(&events).Error
+	return events, err
+}
+
+func (r *matchEventRepositoryImpl) FindByMatchAndPlayer(ctx context.Context, matchID, playerID uuid.UUID) ([]entity.MatchEvent, error) {
+	var events []entity.MatchEvent
+	err := getDB(ctx, r.db).
+		Where("match_id = ? AND (player_id = ? OR player_in_id = ?)", matchID, playerID, playerID).
+		Order("minute ASC, stoppage_time ASC, created_at ASC").
+		Find(&events).Error
+	return events, err
+}
+
+func (r *matchEventRepositoryImpl) FindAssistByGoalID(ctx context.Context, goalID uuid.UUID) (*entity.MatchEvent, error) {
+	var event entity.MatchEvent
+	err := getDB(ctx, r.db).
+		Where("goal_id = ? AND type = ?", goalID, entity.MatchEventAssist).
+		First(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *matchEventRepositoryImpl) DeleteOrphanedAssists(ctx context.Context, matchID uuid.UUID) error {
+	live := getDB(ctx, r.db).
+		Model(&entity.Goal{}).
+		Select("id").
+		Where("match_id = ?", matchID)
+
+	return getDB(ctx, r.db).
+		Where("match_id = ? AND type = ? AND goal_id NOT IN (?)", matchID, entity.MatchEventAssist, live).
+		Delete(&entity.MatchEvent{}).Error
+}
+
+func (r *matchEventRepositoryImpl) FindCards(ctx context.Context, teamID *uuid.UUID) ([]entity.MatchEvent, error) {
+	var events []entity.MatchEvent
+	query := r.cardScope(ctx).
+		Preload("Player").
+		Preload("Team")
+	if teamID != nil {
+		query = query.Where("match_events.team_id = ?", *teamID)
+	}
+	err := query.Find(&events).Error
+	return events, err
+}
+
+func (r *matchEventRepositoryImpl) FindCardsByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.MatchEvent, error) {
+	var events []entity.MatchEvent
+	err := r.cardScope(ctx).
+		Where("match_events.player_id = ?", playerID).
+		Find(&events).Error
+	return events, err
+}
+
+// cardScope returns a query for card events in the order their matches were played
+func (r *matchEventRepositoryImpl) cardScope(ctx context.Context) *gorm.DB {
+	return getDB(ctx, r.db).
+		Joins("JOIN matches ON matches.id = match_events.match_id AND matches.deleted_at IS NULL").
+		Where("match_events.type IN ?", []entity.MatchEventType{
+			entity.MatchEventYellowCard,
+			entity.MatchEventSecondYellow,
+			entity.MatchEventRedCard,
+		}).
+		Order("matches.match_date ASC, matches.match_time ASC, match_events.minute ASC, match_events.stoppage_time ASC")
+}
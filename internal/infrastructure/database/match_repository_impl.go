@@ -20,12 +20,19 @@ func NewMatchRepository(db *gorm.DB) repository.MatchRepository {
 }
 
 func (r *matchRepositoryImpl) Create(ctx context.Context, match *entity.Match) error {
-	return r.db.WithContext(ctx).Create(match).Error
+	return getDB(ctx, r.db).Create(match).Error
+}
+
+func (r *matchRepositoryImpl) CreateBatch(ctx context.Context, matches []entity.Match) error {
+	if len(matches) == 0 {
+		return nil
+	}
+	return getDB(ctx, r.db).Create(&matches).Error
 }
 
 func (r *matchRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
 	var match entity.Match
-	err := r.db.WithContext(ctx).First(&match, "id = ?", id).Error
+	err := getDB(ctx, r.db).First(&match, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -34,12 +41,17 @@ func (r *matchRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*enti
 
 func (r *matchRepositoryImpl) FindByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
 	var match entity.Match
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Preload("HomeTeam").
 		Preload("AwayTeam").
+		Preload("Venue").
 		Preload("Goals").
 		Preload("Goals.Player").
 		Preload("Goals.Team").
+		Preload("Events").
+		Preload("Events.Player").
+		Preload("Events.PlayerIn").
+		Preload("Events.Team").
 		First(&match, "id = ?", id).Error
 	if err != nil {
 		return nil, err
@@ -48,11 +60,11 @@ func (r *matchRepositoryImpl) FindByIDWithDetails(ctx context.Context, id uuid.U
 }
 
 func (r *matchRepositoryImpl) Update(ctx context.Context, match *entity.Match) error {
-	return r.db.WithContext(ctx).Save(match).Error
+	return getDB(ctx, r.db).Save(match).Error
 }
 
 func (r *matchRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Match{}, "id = ?", id).Error
+	return getDB(ctx, r.db).Delete(&entity.Match{}, "id = ?", id).Error
 }
 
 func (r *matchRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
@@ -61,12 +73,12 @@ func (r *matchRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]e
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).Model(&entity.Match{}).Count(&total).Error
+	err := getDB(ctx, r.db).Model(&entity.Match{}).Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Offset(offset).
@@ -86,7 +98,7 @@ func (r *matchRepositoryImpl) FindByDateRange(ctx context.Context, startDate, en
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Model(&entity.Match{}).
 		Where("match_date BETWEEN ? AND ?", startDate, endDate).
 		Count(&total).Error
@@ -94,7 +106,7 @@ func (r *matchRepositoryImpl) FindByDateRange(ctx context.Context, startDate, en
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Where("match_date BETWEEN ? AND ?", startDate, endDate).
@@ -115,7 +127,7 @@ func (r *matchRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Model(&entity.Match{}).
 		Where("home_team_id = ? OR away_team_id = ?", teamID, teamID).
 		Count(&total).Error
@@ -123,7 +135,7 @@ func (r *matchRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Where("home_team_id = ? OR away_team_id = ?", teamID, teamID).
@@ -144,7 +156,7 @@ func (r *matchRepositoryImpl) FindByStatus(ctx context.Context, status entity.Ma
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Model(&entity.Match{}).
 		Where("status = ?", status).
 		Count(&total).Error
@@ -152,7 +164,7 @@ func (r *matchRepositoryImpl) FindByStatus(ctx context.Context, status entity.Ma
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Where("status = ?", status).
@@ -167,56 +179,98 @@ func (r *matchRepositoryImpl) FindByStatus(ctx context.Context, status entity.Ma
 	return matches, total, nil
 }
 
+func (r *matchRepositoryImpl) FindBySeasonID(ctx context.Context, seasonID uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
+	var matches []entity.Match
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := getDB(ctx, r.db).
+		Model(&entity.Match{}).
+		Where("season_id = ?", seasonID).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = getDB(ctx, r.db).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Where("season_id = ?", seasonID).
+		Offset(offset).
+		Limit(limit).
+		Order("match_date ASC, match_time ASC").
+		Find(&matches).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return matches, total, nil
+}
+
 func (r *matchRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Model(&entity.Match{}).
 		Where("id = ?", id).
 		Count(&count).Error
 	return count > 0, err
 }
 
-func (r *matchRepositoryImpl) GetTeamWinCount(ctx context.Context, teamID uuid.UUID, isHome bool) (int64, error) {
-	var count int64
-	var query *gorm.DB
+// winnerTeamExpression selects the winning side of a match with a winner
+const winnerTeamExpression = "CASE WHEN home_score > away_score THEN home_team_id ELSE away_team_id END"
 
-	if isHome {
-		query = r.db.WithContext(ctx).
-			Model(&entity.Match{}).
-			Where("home_team_id = ? AND status = ? AND home_score > away_score", teamID, entity.MatchStatusCompleted)
-	} else {
-		query = r.db.WithContext(ctx).
-			Model(&entity.Match{}).
-			Where("away_team_id = ? AND status = ? AND away_score > home_score", teamID, entity.MatchStatusCompleted)
+func (r *matchRepositoryImpl) CountWinsByTeams(ctx context.Context, teamIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	wins := make(map[uuid.UUID]int64, len(teamIDs))
+	if len(teamIDs) == 0 {
+		return wins, nil
 	}
 
-	err := query.Count(&count).Error
-	return count, err
+	var rows []struct {
+		TeamID uuid.UUID
+		Wins   int64
+	}
+	err := getDB(ctx, r.db).
+		Model(&entity.Match{}).
+		Select(winnerTeamExpression+" AS team_id, COUNT(*) AS wins").
+		Where("status = ?", entity.MatchStatusCompleted).
+		Where("(home_score > away_score AND home_team_id IN ?) OR (away_score > home_score AND away_team_id IN ?)", teamIDs, teamIDs).
+		Group(winnerTeamExpression).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		wins[row.TeamID] = row.Wins
+	}
+	return wins, nil
 }
 
-func (r *matchRepositoryImpl) GetCompletedMatches(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
+func (r *matchRepositoryImpl) GetCompletedMatches(ctx context.Context, seasonID *uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
 	var matches []entity.Match
 	var total int64
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).
+	err := r.completedScope(ctx, seasonID).
 		Model(&entity.Match{}).
-		Where("status = ?", entity.MatchStatusCompleted).
 		Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = r.completedScope(ctx, seasonID).
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Preload("Goals").
 		Preload("Goals.Player").
-		Where("status = ?", entity.MatchStatusCompleted).
+		Preload("Events").
+		Preload("Events.Player").
+		Preload("Events.PlayerIn").
 		Offset(offset).
 		Limit(limit).
-		Order("match_date DESC, match_time DESC").
+		Order("match_date DESC, match_time DESC, id ASC").
 		Find(&matches).Error
 	if err != nil {
 		return nil, 0, err
@@ -224,3 +278,122 @@ func (r *matchRepositoryImpl) GetCompletedMatches(ctx context.Context, page, lim
 
 	return matches, total, nil
 }
+
+func (r *matchRepositoryImpl) FindAllCompleted(ctx context.Context, seasonID *uuid.UUID) ([]entity.Match, error) {
+	var matches []entity.Match
+	err := r.completedScope(ctx, seasonID).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Order("match_date ASC, match_time ASC, id ASC").
+		Find(&matches).Error
+	return matches, err
+}
+
+func (r *matchRepositoryImpl) FindByGroupID(ctx context.Context, groupID uuid.UUID) ([]entity.Match, error) {
+	var matches []entity.Match
+	err := getDB(ctx, r.db).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Where("group_id = ?", groupID).
+		Order("match_date ASC, match_time ASC").
+		Find(&matches).Error
+	return matches, err
+}
+
+// FindPlayedByPlayer returns the completed matches the player took part in:
+// those they started, came on or off in as a substitute, or scored in
+func (r *matchRepositoryImpl) FindPlayedByPlayer(ctx context.Context, playerID uuid.UUID) ([]entity.Match, error) {
+	db := getDB(ctx, r.db)
+
+	started := db.Model(&entity.LineupPlayer{}).
+		Select("lineups.match_id").
+		Joins("JOIN lineups ON lineups.id = lineup_players.lineup_id AND lineups.deleted_at IS NULL").
+		Where("lineup_players.player_id = ? AND lineup_players.is_starter = ?", playerID, true)
+	substituted := db.Model(&entity.MatchEvent{}).
+		Select("match_id").
+		Where("type = ? AND (player_id = ? OR player_in_id = ?)", entity.MatchEventSubstitution, playerID, playerID)
+	scored := db.Model(&entity.Goal{}).
+		Select("match_id").
+		Where("player_id = ?", playerID)
+
+	var matches []entity.Match
+	err := r.completedScope(ctx, nil).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Preload("Goals").
+		Where("id IN (?) OR id IN (?) OR id IN (?)", started, substituted, scored).
+		Order("match_date ASC, match_time ASC").
+		Find(&matches).Error
+	return matches, err
+}
+
+func (r *matchRepositoryImpl) FindCompletedByTeam(ctx context.Context, teamID uuid.UUID, from, to *time.Time) ([]entity.Match, error) {
+	query := r.completedScope(ctx, nil).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Where("home_team_id = ? OR away_team_id = ?", teamID, teamID)
+	if from != nil {
+		query = query.Where("match_date >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("match_date <= ?", *to)
+	}
+
+	var matches []entity.Match
+	err := query.
+		Order("match_date ASC, match_time ASC").
+		Find(&matches).Error
+	return matches, err
+}
+
+func (r *matchRepositoryImpl) FindCompletedBetweenTeams(ctx context.Context, teamA, teamB uuid.UUID) ([]entity.Match, error) {
+	var matches []entity.Match
+	err := r.completedScope(ctx, nil).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Preload("Goals.Player").
+		Where("(home_team_id = ? AND away_team_id = ?) OR (home_team_id = ? AND away_team_id = ?)", teamA, teamB, teamB, teamA).
+		Order("match_date DESC, match_time DESC").
+		Find(&matches).Error
+	return matches, err
+}
+
+func (r *matchRepositoryImpl) CountCompletedByTeamBetween(ctx context.Context, teamID uuid.UUID, after, before *entity.Match) (int64, error) {
+	query := r.completedScope(ctx, nil).
+		Model(&entity.Match{}).
+		Where("home_team_id = ? OR away_team_id = ?", teamID, teamID).
+		Where("match_date > ? OR (match_date = ? AND match_time > ?)", after.MatchDate, after.MatchDate, after.MatchTime)
+	if before != nil {
+		query = query.Where("match_date < ? OR (match_date = ? AND match_time < ?)", before.MatchDate, before.MatchDate, before.MatchTime)
+	}
+
+	var count int64
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *matchRepositoryImpl) FindForScheduling(ctx context.Context, from, to time.Time, teamIDs []uuid.UUID, venueID *uuid.UUID) ([]entity.Match, error) {
+	var matches []entity.Match
+	condition := "home_team_id IN ? OR away_team_id IN ?"
+	args := []interface{}{teamIDs, teamIDs}
+	if venueID != nil {
+		condition += " OR venue_id = ?"
+		args = append(args, *venueID)
+	}
+	err := getDB(ctx, r.db).
+		Where(condition, args...).
+		Where("status <> ?", entity.MatchStatusCancelled).
+		Where("match_date BETWEEN ? AND ?", from, to).
+		Order("match_date ASC, match_time ASC").
+		Find(&matches).Error
+	return matches, err
+}
+
+// completedScope returns a query restricted to completed matches, optionally within a season
+func (r *matchRepositoryImpl) completedScope(ctx context.Context, seasonID *uuid.UUID) *gorm.DB {
+	query := getDB(ctx, r.db).Where("status = ?", entity.MatchStatusCompleted)
+	if seasonID != nil {
+		query = query.Where("season_id = ?", *seasonID)
+	}
+	return query
+}
@@ -0,0 +1,85 @@
+-- Baseline schema, as GORM AutoMigrate built it before versioned migrations.
+-- Tables and indexes are only created when missing, so a database built by
+-- AutoMigrate is adopted as it is and brought up to date by the migrations
+-- that follow.
+
+CREATE TABLE IF NOT EXISTS users (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    email VARCHAR(255) NOT NULL,
+    password VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    role VARCHAR(20) DEFAULT 'user',
+    INDEX idx_users_deleted_at (deleted_at),
+    UNIQUE INDEX idx_users_email (email)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS teams (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    name VARCHAR(255) NOT NULL,
+    logo VARCHAR(500),
+    founded_year BIGINT NOT NULL,
+    address VARCHAR(500),
+    city VARCHAR(100) NOT NULL,
+    INDEX idx_teams_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS players (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    team_id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    height DOUBLE NOT NULL,
+    weight DOUBLE NOT NULL,
+    position VARCHAR(20) NOT NULL,
+    jersey_number BIGINT NOT NULL,
+    INDEX idx_players_deleted_at (deleted_at),
+    INDEX idx_players_team_id (team_id),
+    CONSTRAINT fk_teams_players FOREIGN KEY (team_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS matches (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    match_date DATETIME(3) NOT NULL,
+    match_time VARCHAR(10) NOT NULL,
+    home_team_id CHAR(36) NOT NULL,
+    away_team_id CHAR(36) NOT NULL,
+    home_score BIGINT DEFAULT NULL,
+    away_score BIGINT DEFAULT NULL,
+    status VARCHAR(20) DEFAULT 'scheduled',
+    INDEX idx_matches_deleted_at (deleted_at),
+    INDEX idx_matches_match_date (match_date),
+    INDEX idx_matches_home_team_id (home_team_id),
+    INDEX idx_matches_away_team_id (away_team_id),
+    CONSTRAINT fk_matches_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_matches_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS goals (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    match_id CHAR(36) NOT NULL,
+    player_id CHAR(36) NOT NULL,
+    team_id CHAR(36) NOT NULL,
+    minute BIGINT NOT NULL,
+    is_own_goal BOOLEAN DEFAULT FALSE,
+    INDEX idx_goals_deleted_at (deleted_at),
+    INDEX idx_goals_match_id (match_id),
+    INDEX idx_goals_player_id (player_id),
+    INDEX idx_goals_team_id (team_id),
+    CONSTRAINT fk_matches_goals FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_goals_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_goals_team FOREIGN KEY (team_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,24 @@
+-- Venues, and the home ground of teams and venue of matches
+
+CREATE TABLE venues (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    name VARCHAR(255) NOT NULL,
+    address VARCHAR(500),
+    city VARCHAR(100) NOT NULL,
+    capacity BIGINT DEFAULT 0,
+    surface VARCHAR(20) DEFAULT 'grass',
+    INDEX idx_venues_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE teams
+    ADD COLUMN home_venue_id CHAR(36),
+    ADD INDEX idx_teams_home_venue_id (home_venue_id),
+    ADD CONSTRAINT fk_teams_home_venue FOREIGN KEY (home_venue_id) REFERENCES venues (id);
+
+ALTER TABLE matches
+    ADD COLUMN venue_id CHAR(36),
+    ADD INDEX idx_matches_venue_id (venue_id),
+    ADD CONSTRAINT fk_matches_venue FOREIGN KEY (venue_id) REFERENCES venues (id);
@@ -0,0 +1,7 @@
+ALTER TABLE matches DROP COLUMN group_id;
+ALTER TABLE matches DROP FOREIGN KEY fk_matches_season;
+ALTER TABLE matches DROP COLUMN season_id;
+DROP TABLE group_teams;
+DROP TABLE season_groups;
+DROP TABLE seasons;
+DROP TABLE competitions;
@@ -0,0 +1,60 @@
+-- Competitions with their seasons and groups, and the season and group of matches
+
+CREATE TABLE competitions (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(20) DEFAULT 'league',
+    description VARCHAR(1000),
+    INDEX idx_competitions_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE seasons (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    competition_id CHAR(36) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    start_date DATETIME(3) NOT NULL,
+    end_date DATETIME(3) NOT NULL,
+    INDEX idx_seasons_deleted_at (deleted_at),
+    INDEX idx_seasons_competition_id (competition_id),
+    CONSTRAINT fk_seasons_competition FOREIGN KEY (competition_id) REFERENCES competitions (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE season_groups (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    season_id CHAR(36) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    INDEX idx_season_groups_deleted_at (deleted_at),
+    INDEX idx_season_groups_season_id (season_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE group_teams (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    group_id CHAR(36) NOT NULL,
+    team_id CHAR(36) NOT NULL,
+    INDEX idx_group_teams_deleted_at (deleted_at),
+    INDEX idx_group_teams_group_id (group_id),
+    INDEX idx_group_teams_team_id (team_id),
+    CONSTRAINT fk_group_teams_group FOREIGN KEY (group_id) REFERENCES season_groups (id),
+    CONSTRAINT fk_group_teams_team FOREIGN KEY (team_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE matches
+    ADD COLUMN season_id CHAR(36),
+    ADD INDEX idx_matches_season_id (season_id),
+    ADD CONSTRAINT fk_matches_season FOREIGN KEY (season_id) REFERENCES seasons (id);
+
+ALTER TABLE matches
+    ADD COLUMN group_id CHAR(36),
+    ADD INDEX idx_matches_group_id (group_id);
@@ -0,0 +1,49 @@
+-- Knockout brackets and their ties
+
+CREATE TABLE brackets (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    season_id CHAR(36),
+    name VARCHAR(255) NOT NULL,
+    rounds BIGINT NOT NULL,
+    two_legged BOOLEAN DEFAULT FALSE,
+    two_legged_final BOOLEAN DEFAULT FALSE,
+    start_date DATETIME(3) NOT NULL,
+    kickoff_time VARCHAR(10) NOT NULL,
+    round_interval_days BIGINT NOT NULL DEFAULT 7,
+    leg_interval_days BIGINT NOT NULL DEFAULT 7,
+    INDEX idx_brackets_deleted_at (deleted_at),
+    INDEX idx_brackets_season_id (season_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE bracket_ties (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    bracket_id CHAR(36) NOT NULL,
+    round BIGINT NOT NULL,
+    slot BIGINT NOT NULL,
+    home_seed BIGINT,
+    away_seed BIGINT,
+    home_team_id CHAR(36),
+    away_team_id CHAR(36),
+    two_legged BOOLEAN DEFAULT FALSE,
+    is_bye BOOLEAN DEFAULT FALSE,
+    first_leg_match_id CHAR(36),
+    second_leg_match_id CHAR(36),
+    winner_team_id CHAR(36),
+    INDEX idx_bracket_ties_deleted_at (deleted_at),
+    INDEX idx_bracket_ties_bracket_id (bracket_id),
+    INDEX idx_bracket_ties_home_team_id (home_team_id),
+    INDEX idx_bracket_ties_away_team_id (away_team_id),
+    INDEX idx_bracket_ties_first_leg_match_id (first_leg_match_id),
+    INDEX idx_bracket_ties_second_leg_match_id (second_leg_match_id),
+    CONSTRAINT fk_bracket_ties_bracket FOREIGN KEY (bracket_id) REFERENCES brackets (id),
+    CONSTRAINT fk_bracket_ties_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_bracket_ties_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_bracket_ties_first_leg_match FOREIGN KEY (first_leg_match_id) REFERENCES matches (id),
+    CONSTRAINT fk_bracket_ties_second_leg_match FOREIGN KEY (second_leg_match_id) REFERENCES matches (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
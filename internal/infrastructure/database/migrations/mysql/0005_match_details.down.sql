@@ -0,0 +1,10 @@
+ALTER TABLE goals DROP COLUMN is_penalty;
+ALTER TABLE goals DROP COLUMN stoppage_time;
+ALTER TABLE matches DROP COLUMN period_started_at;
+ALTER TABLE matches DROP COLUMN period;
+ALTER TABLE matches DROP COLUMN away_penalties;
+ALTER TABLE matches DROP COLUMN home_penalties;
+ALTER TABLE matches DROP COLUMN extra_time;
+DROP TABLE lineup_players;
+DROP TABLE lineups;
+DROP TABLE match_events;
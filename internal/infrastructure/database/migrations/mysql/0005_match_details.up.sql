@@ -0,0 +1,84 @@
+-- Extra time, shootouts and live periods of matches, goal details,
+-- match events and lineups
+
+CREATE TABLE match_events (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    match_id CHAR(36) NOT NULL,
+    team_id CHAR(36) NOT NULL,
+    player_id CHAR(36) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    minute BIGINT NOT NULL,
+    stoppage_time BIGINT NOT NULL DEFAULT 0,
+    player_in_id CHAR(36),
+    goal_id CHAR(36),
+    INDEX idx_match_events_deleted_at (deleted_at),
+    INDEX idx_match_events_match_id (match_id),
+    INDEX idx_match_events_team_id (team_id),
+    INDEX idx_match_events_player_id (player_id),
+    INDEX idx_match_events_type (type),
+    INDEX idx_match_events_player_in_id (player_in_id),
+    INDEX idx_match_events_goal_id (goal_id),
+    CONSTRAINT fk_match_events_match FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_match_events_team FOREIGN KEY (team_id) REFERENCES teams (id),
+    CONSTRAINT fk_match_events_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_match_events_player_in FOREIGN KEY (player_in_id) REFERENCES players (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE lineups (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    match_id CHAR(36) NOT NULL,
+    team_id CHAR(36) NOT NULL,
+    formation VARCHAR(20),
+    captain_id CHAR(36) NOT NULL,
+    goalkeeper_id CHAR(36) NOT NULL,
+    INDEX idx_lineups_deleted_at (deleted_at),
+    INDEX idx_lineups_match_id (match_id),
+    INDEX idx_lineups_team_id (team_id),
+    CONSTRAINT fk_lineups_team FOREIGN KEY (team_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE lineup_players (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    lineup_id CHAR(36) NOT NULL,
+    player_id CHAR(36) NOT NULL,
+    jersey_number BIGINT NOT NULL,
+    is_starter BOOLEAN DEFAULT FALSE,
+    INDEX idx_lineup_players_deleted_at (deleted_at),
+    INDEX idx_lineup_players_lineup_id (lineup_id),
+    INDEX idx_lineup_players_player_id (player_id),
+    CONSTRAINT fk_lineup_players_lineup FOREIGN KEY (lineup_id) REFERENCES lineups (id),
+    CONSTRAINT fk_lineup_players_player FOREIGN KEY (player_id) REFERENCES players (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE matches
+    ADD COLUMN extra_time BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE matches
+    ADD COLUMN home_penalties BIGINT DEFAULT NULL;
+
+ALTER TABLE matches
+    ADD COLUMN away_penalties BIGINT DEFAULT NULL;
+
+ALTER TABLE matches
+    ADD COLUMN period VARCHAR(20);
+
+ALTER TABLE matches
+    ADD COLUMN period_started_at DATETIME(3);
+
+ALTER TABLE goals
+    ADD COLUMN stoppage_time BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE goals
+    ADD COLUMN is_penalty BOOLEAN DEFAULT FALSE;
+
+-- Results recorded before live periods existed are full time
+UPDATE matches SET period = 'full_time' WHERE status = 'completed';
@@ -0,0 +1,60 @@
+-- Transfers, player availability and suspensions
+
+CREATE TABLE transfers (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    player_id CHAR(36) NOT NULL,
+    from_team_id CHAR(36) NOT NULL,
+    to_team_id CHAR(36) NOT NULL,
+    transfer_date DATETIME(3) NOT NULL,
+    fee DOUBLE DEFAULT 0,
+    is_loan BOOLEAN DEFAULT FALSE,
+    INDEX idx_transfers_deleted_at (deleted_at),
+    INDEX idx_transfers_player_id (player_id),
+    INDEX idx_transfers_from_team_id (from_team_id),
+    INDEX idx_transfers_to_team_id (to_team_id),
+    INDEX idx_transfers_transfer_date (transfer_date),
+    CONSTRAINT fk_transfers_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_transfers_from_team FOREIGN KEY (from_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_transfers_to_team FOREIGN KEY (to_team_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE player_availabilities (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    player_id CHAR(36) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    reason VARCHAR(500),
+    start_date DATETIME(3) NOT NULL,
+    expected_return DATETIME(3),
+    end_date DATETIME(3),
+    INDEX idx_player_availabilities_deleted_at (deleted_at),
+    INDEX idx_player_availabilities_player_id (player_id),
+    INDEX idx_player_availabilities_start_date (start_date),
+    INDEX idx_player_availabilities_end_date (end_date),
+    CONSTRAINT fk_player_availabilities_player FOREIGN KEY (player_id) REFERENCES players (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE suspensions (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    player_id CHAR(36) NOT NULL,
+    team_id CHAR(36) NOT NULL,
+    match_id CHAR(36) NOT NULL,
+    event_id CHAR(36) NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    matches BIGINT NOT NULL,
+    INDEX idx_suspensions_deleted_at (deleted_at),
+    INDEX idx_suspensions_player_id (player_id),
+    INDEX idx_suspensions_team_id (team_id),
+    INDEX idx_suspensions_match_id (match_id),
+    CONSTRAINT fk_suspensions_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_suspensions_team FOREIGN KEY (team_id) REFERENCES teams (id),
+    CONSTRAINT fk_suspensions_match FOREIGN KEY (match_id) REFERENCES matches (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,31 @@
+-- Match officials and their assignments
+
+CREATE TABLE officials (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    name VARCHAR(255) NOT NULL,
+    grade VARCHAR(20) NOT NULL,
+    certification_expiry DATETIME(3) NOT NULL,
+    user_id CHAR(36),
+    INDEX idx_officials_deleted_at (deleted_at),
+    UNIQUE INDEX idx_officials_user_id (user_id),
+    CONSTRAINT fk_officials_user FOREIGN KEY (user_id) REFERENCES users (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE match_officials (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    match_id CHAR(36) NOT NULL,
+    official_id CHAR(36) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    reported_at DATETIME(3),
+    INDEX idx_match_officials_deleted_at (deleted_at),
+    INDEX idx_match_officials_match_id (match_id),
+    INDEX idx_match_officials_official_id (official_id),
+    CONSTRAINT fk_match_officials_match FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_match_officials_official FOREIGN KEY (official_id) REFERENCES officials (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
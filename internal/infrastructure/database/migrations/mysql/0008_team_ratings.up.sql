@@ -0,0 +1,32 @@
+-- Team ratings and their history
+
+CREATE TABLE team_ratings (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    team_id CHAR(36) NOT NULL,
+    rating DOUBLE NOT NULL,
+    matches BIGINT NOT NULL DEFAULT 0,
+    INDEX idx_team_ratings_deleted_at (deleted_at),
+    UNIQUE INDEX idx_team_ratings_team_id (team_id),
+    CONSTRAINT fk_team_ratings_team FOREIGN KEY (team_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE rating_histories (
+    id CHAR(36) PRIMARY KEY,
+    created_at DATETIME(3),
+    updated_at DATETIME(3),
+    deleted_at DATETIME(3),
+    team_id CHAR(36) NOT NULL,
+    match_id CHAR(36) NOT NULL,
+    opponent_id CHAR(36) NOT NULL,
+    rating_before DOUBLE NOT NULL,
+    rating_after DOUBLE NOT NULL,
+    INDEX idx_rating_histories_deleted_at (deleted_at),
+    INDEX idx_rating_histories_team_id (team_id),
+    INDEX idx_rating_histories_match_id (match_id),
+    CONSTRAINT fk_rating_histories_team FOREIGN KEY (team_id) REFERENCES teams (id),
+    CONSTRAINT fk_rating_histories_match FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_rating_histories_opponent FOREIGN KEY (opponent_id) REFERENCES teams (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,85 @@
+-- Baseline schema, as GORM AutoMigrate built it before versioned migrations.
+-- Tables and indexes are only created when missing, so a database built by
+-- AutoMigrate is adopted as it is and brought up to date by the migrations
+-- that follow.
+
+CREATE TABLE IF NOT EXISTS users (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    email VARCHAR(255) NOT NULL,
+    password VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    role VARCHAR(20) DEFAULT 'user'
+);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name VARCHAR(255) NOT NULL,
+    logo VARCHAR(500),
+    founded_year BIGINT NOT NULL,
+    address VARCHAR(500),
+    city VARCHAR(100) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams (deleted_at);
+
+CREATE TABLE IF NOT EXISTS players (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    team_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    height DECIMAL NOT NULL,
+    weight DECIMAL NOT NULL,
+    position VARCHAR(20) NOT NULL,
+    jersey_number BIGINT NOT NULL,
+    CONSTRAINT fk_teams_players FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX IF NOT EXISTS idx_players_deleted_at ON players (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_players_team_id ON players (team_id);
+
+CREATE TABLE IF NOT EXISTS matches (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    match_date TIMESTAMPTZ NOT NULL,
+    match_time VARCHAR(10) NOT NULL,
+    home_team_id UUID NOT NULL,
+    away_team_id UUID NOT NULL,
+    home_score BIGINT DEFAULT NULL,
+    away_score BIGINT DEFAULT NULL,
+    status VARCHAR(20) DEFAULT 'scheduled',
+    CONSTRAINT fk_matches_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_matches_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id)
+);
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_match_date ON matches (match_date);
+CREATE INDEX IF NOT EXISTS idx_matches_home_team_id ON matches (home_team_id);
+CREATE INDEX IF NOT EXISTS idx_matches_away_team_id ON matches (away_team_id);
+
+CREATE TABLE IF NOT EXISTS goals (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    match_id UUID NOT NULL,
+    player_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    minute BIGINT NOT NULL,
+    is_own_goal BOOLEAN DEFAULT FALSE,
+    CONSTRAINT fk_matches_goals FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_goals_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_goals_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX IF NOT EXISTS idx_goals_deleted_at ON goals (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_goals_match_id ON goals (match_id);
+CREATE INDEX IF NOT EXISTS idx_goals_player_id ON goals (player_id);
+CREATE INDEX IF NOT EXISTS idx_goals_team_id ON goals (team_id);
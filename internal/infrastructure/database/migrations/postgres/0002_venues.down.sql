@@ -0,0 +1,3 @@
+ALTER TABLE matches DROP COLUMN venue_id;
+ALTER TABLE teams DROP COLUMN home_venue_id;
+DROP TABLE venues;
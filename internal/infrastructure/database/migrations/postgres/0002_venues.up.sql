@@ -0,0 +1,20 @@
+-- Venues, and the home ground of teams and venue of matches
+
+CREATE TABLE venues (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name VARCHAR(255) NOT NULL,
+    address VARCHAR(500),
+    city VARCHAR(100) NOT NULL,
+    capacity BIGINT DEFAULT 0,
+    surface VARCHAR(20) DEFAULT 'grass'
+);
+CREATE INDEX idx_venues_deleted_at ON venues (deleted_at);
+
+ALTER TABLE teams ADD COLUMN home_venue_id UUID CONSTRAINT fk_teams_home_venue REFERENCES venues (id);
+CREATE INDEX idx_teams_home_venue_id ON teams (home_venue_id);
+
+ALTER TABLE matches ADD COLUMN venue_id UUID CONSTRAINT fk_matches_venue REFERENCES venues (id);
+CREATE INDEX idx_matches_venue_id ON matches (venue_id);
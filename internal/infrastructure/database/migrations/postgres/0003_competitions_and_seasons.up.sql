@@ -0,0 +1,57 @@
+-- Competitions with their seasons and groups, and the season and group of matches
+
+CREATE TABLE competitions (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(20) DEFAULT 'league',
+    description VARCHAR(1000)
+);
+CREATE INDEX idx_competitions_deleted_at ON competitions (deleted_at);
+
+CREATE TABLE seasons (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    competition_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_seasons_competition FOREIGN KEY (competition_id) REFERENCES competitions (id)
+);
+CREATE INDEX idx_seasons_deleted_at ON seasons (deleted_at);
+CREATE INDEX idx_seasons_competition_id ON seasons (competition_id);
+
+CREATE TABLE season_groups (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    season_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL
+);
+CREATE INDEX idx_season_groups_deleted_at ON season_groups (deleted_at);
+CREATE INDEX idx_season_groups_season_id ON season_groups (season_id);
+
+CREATE TABLE group_teams (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    group_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    CONSTRAINT fk_group_teams_group FOREIGN KEY (group_id) REFERENCES season_groups (id),
+    CONSTRAINT fk_group_teams_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX idx_group_teams_deleted_at ON group_teams (deleted_at);
+CREATE INDEX idx_group_teams_group_id ON group_teams (group_id);
+CREATE INDEX idx_group_teams_team_id ON group_teams (team_id);
+
+ALTER TABLE matches ADD COLUMN season_id UUID CONSTRAINT fk_matches_season REFERENCES seasons (id);
+CREATE INDEX idx_matches_season_id ON matches (season_id);
+
+ALTER TABLE matches ADD COLUMN group_id UUID;
+CREATE INDEX idx_matches_group_id ON matches (group_id);
@@ -0,0 +1,49 @@
+-- Knockout brackets and their ties
+
+CREATE TABLE brackets (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    season_id UUID,
+    name VARCHAR(255) NOT NULL,
+    rounds BIGINT NOT NULL,
+    two_legged BOOLEAN DEFAULT FALSE,
+    two_legged_final BOOLEAN DEFAULT FALSE,
+    start_date TIMESTAMPTZ NOT NULL,
+    kickoff_time VARCHAR(10) NOT NULL,
+    round_interval_days BIGINT NOT NULL DEFAULT 7,
+    leg_interval_days BIGINT NOT NULL DEFAULT 7
+);
+CREATE INDEX idx_brackets_deleted_at ON brackets (deleted_at);
+CREATE INDEX idx_brackets_season_id ON brackets (season_id);
+
+CREATE TABLE bracket_ties (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    bracket_id UUID NOT NULL,
+    round BIGINT NOT NULL,
+    slot BIGINT NOT NULL,
+    home_seed BIGINT,
+    away_seed BIGINT,
+    home_team_id UUID,
+    away_team_id UUID,
+    two_legged BOOLEAN DEFAULT FALSE,
+    is_bye BOOLEAN DEFAULT FALSE,
+    first_leg_match_id UUID,
+    second_leg_match_id UUID,
+    winner_team_id UUID,
+    CONSTRAINT fk_bracket_ties_bracket FOREIGN KEY (bracket_id) REFERENCES brackets (id),
+    CONSTRAINT fk_bracket_ties_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_bracket_ties_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_bracket_ties_first_leg_match FOREIGN KEY (first_leg_match_id) REFERENCES matches (id),
+    CONSTRAINT fk_bracket_ties_second_leg_match FOREIGN KEY (second_leg_match_id) REFERENCES matches (id)
+);
+CREATE INDEX idx_bracket_ties_deleted_at ON bracket_ties (deleted_at);
+CREATE INDEX idx_bracket_ties_bracket_id ON bracket_ties (bracket_id);
+CREATE INDEX idx_bracket_ties_home_team_id ON bracket_ties (home_team_id);
+CREATE INDEX idx_bracket_ties_away_team_id ON bracket_ties (away_team_id);
+CREATE INDEX idx_bracket_ties_first_leg_match_id ON bracket_ties (first_leg_match_id);
+CREATE INDEX idx_bracket_ties_second_leg_match_id ON bracket_ties (second_leg_match_id);
@@ -0,0 +1,77 @@
+-- Extra time, shootouts and live periods of matches, goal details,
+-- match events and lineups
+
+CREATE TABLE match_events (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    match_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    player_id UUID NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    minute BIGINT NOT NULL,
+    stoppage_time BIGINT NOT NULL DEFAULT 0,
+    player_in_id UUID,
+    goal_id UUID,
+    CONSTRAINT fk_match_events_match FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_match_events_team FOREIGN KEY (team_id) REFERENCES teams (id),
+    CONSTRAINT fk_match_events_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_match_events_player_in FOREIGN KEY (player_in_id) REFERENCES players (id)
+);
+CREATE INDEX idx_match_events_deleted_at ON match_events (deleted_at);
+CREATE INDEX idx_match_events_match_id ON match_events (match_id);
+CREATE INDEX idx_match_events_team_id ON match_events (team_id);
+CREATE INDEX idx_match_events_player_id ON match_events (player_id);
+CREATE INDEX idx_match_events_type ON match_events (type);
+CREATE INDEX idx_match_events_player_in_id ON match_events (player_in_id);
+CREATE INDEX idx_match_events_goal_id ON match_events (goal_id);
+
+CREATE TABLE lineups (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    match_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    formation VARCHAR(20),
+    captain_id UUID NOT NULL,
+    goalkeeper_id UUID NOT NULL,
+    CONSTRAINT fk_lineups_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX idx_lineups_deleted_at ON lineups (deleted_at);
+CREATE INDEX idx_lineups_match_id ON lineups (match_id);
+CREATE INDEX idx_lineups_team_id ON lineups (team_id);
+
+CREATE TABLE lineup_players (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    lineup_id UUID NOT NULL,
+    player_id UUID NOT NULL,
+    jersey_number BIGINT NOT NULL,
+    is_starter BOOLEAN DEFAULT FALSE,
+    CONSTRAINT fk_lineup_players_lineup FOREIGN KEY (lineup_id) REFERENCES lineups (id),
+    CONSTRAINT fk_lineup_players_player FOREIGN KEY (player_id) REFERENCES players (id)
+);
+CREATE INDEX idx_lineup_players_deleted_at ON lineup_players (deleted_at);
+CREATE INDEX idx_lineup_players_lineup_id ON lineup_players (lineup_id);
+CREATE INDEX idx_lineup_players_player_id ON lineup_players (player_id);
+
+ALTER TABLE matches ADD COLUMN extra_time BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE matches ADD COLUMN home_penalties BIGINT DEFAULT NULL;
+
+ALTER TABLE matches ADD COLUMN away_penalties BIGINT DEFAULT NULL;
+
+ALTER TABLE matches ADD COLUMN period VARCHAR(20);
+
+ALTER TABLE matches ADD COLUMN period_started_at TIMESTAMPTZ;
+
+ALTER TABLE goals ADD COLUMN stoppage_time BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE goals ADD COLUMN is_penalty BOOLEAN DEFAULT FALSE;
+
+-- Results recorded before live periods existed are full time
+UPDATE matches SET period = 'full_time' WHERE status = 'completed';
@@ -0,0 +1,3 @@
+DROP TABLE suspensions;
+DROP TABLE player_availabilities;
+DROP TABLE transfers;
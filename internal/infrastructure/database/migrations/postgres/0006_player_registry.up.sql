@@ -0,0 +1,60 @@
+-- Transfers, player availability and suspensions
+
+CREATE TABLE transfers (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    player_id UUID NOT NULL,
+    from_team_id UUID NOT NULL,
+    to_team_id UUID NOT NULL,
+    transfer_date TIMESTAMPTZ NOT NULL,
+    fee DOUBLE PRECISION DEFAULT 0,
+    is_loan BOOLEAN DEFAULT FALSE,
+    CONSTRAINT fk_transfers_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_transfers_from_team FOREIGN KEY (from_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_transfers_to_team FOREIGN KEY (to_team_id) REFERENCES teams (id)
+);
+CREATE INDEX idx_transfers_deleted_at ON transfers (deleted_at);
+CREATE INDEX idx_transfers_player_id ON transfers (player_id);
+CREATE INDEX idx_transfers_from_team_id ON transfers (from_team_id);
+CREATE INDEX idx_transfers_to_team_id ON transfers (to_team_id);
+CREATE INDEX idx_transfers_transfer_date ON transfers (transfer_date);
+
+CREATE TABLE player_availabilities (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    player_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    reason VARCHAR(500),
+    start_date TIMESTAMPTZ NOT NULL,
+    expected_return TIMESTAMPTZ,
+    end_date TIMESTAMPTZ,
+    CONSTRAINT fk_player_availabilities_player FOREIGN KEY (player_id) REFERENCES players (id)
+);
+CREATE INDEX idx_player_availabilities_deleted_at ON player_availabilities (deleted_at);
+CREATE INDEX idx_player_availabilities_player_id ON player_availabilities (player_id);
+CREATE INDEX idx_player_availabilities_start_date ON player_availabilities (start_date);
+CREATE INDEX idx_player_availabilities_end_date ON player_availabilities (end_date);
+
+CREATE TABLE suspensions (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    player_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    match_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    matches BIGINT NOT NULL,
+    CONSTRAINT fk_suspensions_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_suspensions_team FOREIGN KEY (team_id) REFERENCES teams (id),
+    CONSTRAINT fk_suspensions_match FOREIGN KEY (match_id) REFERENCES matches (id)
+);
+CREATE INDEX idx_suspensions_deleted_at ON suspensions (deleted_at);
+CREATE INDEX idx_suspensions_player_id ON suspensions (player_id);
+CREATE INDEX idx_suspensions_team_id ON suspensions (team_id);
+CREATE INDEX idx_suspensions_match_id ON suspensions (match_id);
@@ -0,0 +1,31 @@
+-- Match officials and their assignments
+
+CREATE TABLE officials (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name VARCHAR(255) NOT NULL,
+    grade VARCHAR(20) NOT NULL,
+    certification_expiry TIMESTAMPTZ NOT NULL,
+    user_id UUID,
+    CONSTRAINT fk_officials_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX idx_officials_deleted_at ON officials (deleted_at);
+CREATE UNIQUE INDEX idx_officials_user_id ON officials (user_id);
+
+CREATE TABLE match_officials (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    match_id UUID NOT NULL,
+    official_id UUID NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    reported_at TIMESTAMPTZ,
+    CONSTRAINT fk_match_officials_match FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_match_officials_official FOREIGN KEY (official_id) REFERENCES officials (id)
+);
+CREATE INDEX idx_match_officials_deleted_at ON match_officials (deleted_at);
+CREATE INDEX idx_match_officials_match_id ON match_officials (match_id);
+CREATE INDEX idx_match_officials_official_id ON match_officials (official_id);
@@ -0,0 +1,32 @@
+-- Team ratings and their history
+
+CREATE TABLE team_ratings (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    team_id UUID NOT NULL,
+    rating DOUBLE PRECISION NOT NULL,
+    matches BIGINT NOT NULL DEFAULT 0,
+    CONSTRAINT fk_team_ratings_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX idx_team_ratings_deleted_at ON team_ratings (deleted_at);
+CREATE UNIQUE INDEX idx_team_ratings_team_id ON team_ratings (team_id);
+
+CREATE TABLE rating_histories (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    team_id UUID NOT NULL,
+    match_id UUID NOT NULL,
+    opponent_id UUID NOT NULL,
+    rating_before DOUBLE PRECISION NOT NULL,
+    rating_after DOUBLE PRECISION NOT NULL,
+    CONSTRAINT fk_rating_histories_team FOREIGN KEY (team_id) REFERENCES teams (id),
+    CONSTRAINT fk_rating_histories_match FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_rating_histories_opponent FOREIGN KEY (opponent_id) REFERENCES teams (id)
+);
+CREATE INDEX idx_rating_histories_deleted_at ON rating_histories (deleted_at);
+CREATE INDEX idx_rating_histories_team_id ON rating_histories (team_id);
+CREATE INDEX idx_rating_histories_match_id ON rating_histories (match_id);
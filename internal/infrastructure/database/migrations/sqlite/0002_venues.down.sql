@@ -0,0 +1,5 @@
+DROP INDEX idx_matches_venue_id;
+ALTER TABLE matches DROP COLUMN venue_id;
+DROP INDEX idx_teams_home_venue_id;
+ALTER TABLE teams DROP COLUMN home_venue_id;
+DROP TABLE venues;
@@ -0,0 +1,22 @@
+-- Venues, and the home ground of teams and venue of matches
+-- SQLite cannot drop a column used by a foreign key, so the columns added
+-- here have no foreign key constraint.
+
+CREATE TABLE venues (
+    id TEXT PRIMARY KEY,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    name VARCHAR(255) NOT NULL,
+    address VARCHAR(500),
+    city VARCHAR(100) NOT NULL,
+    capacity INTEGER DEFAULT 0,
+    surface VARCHAR(20) DEFAULT 'grass'
+);
+CREATE INDEX idx_venues_deleted_at ON venues (deleted_at);
+
+ALTER TABLE teams ADD COLUMN home_venue_id TEXT;
+CREATE INDEX idx_teams_home_venue_id ON teams (home_venue_id);
+
+ALTER TABLE matches ADD COLUMN venue_id TEXT;
+CREATE INDEX idx_matches_venue_id ON matches (venue_id);
@@ -0,0 +1,8 @@
+DROP INDEX idx_matches_group_id;
+ALTER TABLE matches DROP COLUMN group_id;
+DROP INDEX idx_matches_season_id;
+ALTER TABLE matches DROP COLUMN season_id;
+DROP TABLE group_teams;
+DROP TABLE season_groups;
+DROP TABLE seasons;
+DROP TABLE competitions;
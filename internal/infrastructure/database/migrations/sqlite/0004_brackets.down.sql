@@ -0,0 +1,2 @@
+DROP TABLE bracket_ties;
+DROP TABLE brackets;
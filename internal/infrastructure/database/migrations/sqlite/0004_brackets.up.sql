@@ -0,0 +1,49 @@
+-- Knockout brackets and their ties
+
+CREATE TABLE brackets (
+    id TEXT PRIMARY KEY,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    season_id TEXT,
+    name VARCHAR(255) NOT NULL,
+    rounds INTEGER NOT NULL,
+    two_legged BOOLEAN DEFAULT FALSE,
+    two_legged_final BOOLEAN DEFAULT FALSE,
+    start_date DATETIME NOT NULL,
+    kickoff_time VARCHAR(10) NOT NULL,
+    round_interval_days INTEGER NOT NULL DEFAULT 7,
+    leg_interval_days INTEGER NOT NULL DEFAULT 7
+);
+CREATE INDEX idx_brackets_deleted_at ON brackets (deleted_at);
+CREATE INDEX idx_brackets_season_id ON brackets (season_id);
+
+CREATE TABLE bracket_ties (
+    id TEXT PRIMARY KEY,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    bracket_id TEXT NOT NULL,
+    round INTEGER NOT NULL,
+    slot INTEGER NOT NULL,
+    home_seed INTEGER,
+    away_seed INTEGER,
+    home_team_id TEXT,
+    away_team_id TEXT,
+    two_legged BOOLEAN DEFAULT FALSE,
+    is_bye BOOLEAN DEFAULT FALSE,
+    first_leg_match_id TEXT,
+    second_leg_match_id TEXT,
+    winner_team_id TEXT,
+    CONSTRAINT fk_bracket_ties_bracket FOREIGN KEY (bracket_id) REFERENCES brackets (id),
+    CONSTRAINT fk_bracket_ties_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_bracket_ties_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id),
+    CONSTRAINT fk_bracket_ties_first_leg_match FOREIGN KEY (first_leg_match_id) REFERENCES matches (id),
+    CONSTRAINT fk_bracket_ties_second_leg_match FOREIGN KEY (second_leg_match_id) REFERENCES matches (id)
+);
+CREATE INDEX idx_bracket_ties_deleted_at ON bracket_ties (deleted_at);
+CREATE INDEX idx_bracket_ties_bracket_id ON bracket_ties (bracket_id);
+CREATE INDEX idx_bracket_ties_home_team_id ON bracket_ties (home_team_id);
+CREATE INDEX idx_bracket_ties_away_team_id ON bracket_ties (away_team_id);
+CREATE INDEX idx_bracket_ties_first_leg_match_id ON bracket_ties (first_leg_match_id);
+CREATE INDEX idx_bracket_ties_second_leg_match_id ON bracket_ties (second_leg_match_id);
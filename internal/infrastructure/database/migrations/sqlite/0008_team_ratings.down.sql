@@ -0,0 +1,2 @@
+DROP TABLE rating_histories;
+DROP TABLE team_ratings;
@@ -0,0 +1,32 @@
+-- Team ratings and their history
+
+CREATE TABLE team_ratings (
+    id TEXT PRIMARY KEY,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    team_id TEXT NOT NULL,
+    rating REAL NOT NULL,
+    matches INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT fk_team_ratings_team FOREIGN KEY (team_id) REFERENCES teams (id)
+);
+CREATE INDEX idx_team_ratings_deleted_at ON team_ratings (deleted_at);
+CREATE UNIQUE INDEX idx_team_ratings_team_id ON team_ratings (team_id);
+
+CREATE TABLE rating_histories (
+    id TEXT PRIMARY KEY,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    team_id TEXT NOT NULL,
+    match_id TEXT NOT NULL,
+    opponent_id TEXT NOT NULL,
+    rating_before REAL NOT NULL,
+    rating_after REAL NOT NULL,
+    CONSTRAINT fk_rating_histories_team FOREIGN KEY (team_id) REFERENCES teams (id),
+    CONSTRAINT fk_rating_histories_match FOREIGN KEY (match_id) REFERENCES matches (id),
+    CONSTRAINT fk_rating_histories_opponent FOREIGN KEY (opponent_id) REFERENCES teams (id)
+);
+CREATE INDEX idx_rating_histories_deleted_at ON rating_histories (deleted_at);
+CREATE INDEX idx_rating_histories_team_id ON rating_histories (team_id);
+CREATE INDEX idx_rating_histories_match_id ON rating_histories (match_id);
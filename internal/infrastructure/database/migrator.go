@@ -0,0 +1,367 @@
+package database
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed migrations
+var migrationFiles embed.FS
+
+// migrationLockKey identifies the schema lock, so replicas that start
+// together apply migrations one at a time
+const (
+	migrationLockKey  = 7_281_930_012
+	migrationLockName = "ayo_football_schema_migrations"
+)
+
+var (
+	ErrUnsupportedMigrationDriver = errors.New("no migrations for database driver")
+	ErrInvalidMigrationFile       = errors.New("invalid migration file")
+)
+
+// Migration is a versioned schema change with the SQL to apply and revert it
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus reports whether a migration has been applied
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time // Nil while pending
+	Missing   bool       // Applied, but no longer embedded in the binary
+}
+
+// schemaMigration is a row of the schema_migrations table
+type schemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// migrationDialect holds the statements that differ between drivers
+type migrationDialect struct {
+	createTable string
+	lock        func(conn *gorm.DB) error
+	unlock      func(conn *gorm.DB) error
+}
+
+var migrationDialects = map[string]migrationDialect{
+	DriverPostgres: {
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL
+)`,
+		lock: func(conn *gorm.DB) error {
+			return conn.Exec("SELECT pg_advisory_lock(?)", migrationLockKey).Error
+		},
+		unlock: func(conn *gorm.DB) error {
+			return conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockKey).Error
+		},
+	},
+	DriverMySQL: {
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at DATETIME(3) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		lock: func(conn *gorm.DB) error {
+			// A negative timeout waits until the lock is free
+			var acquired int
+			if err := conn.Raw("SELECT GET_LOCK(?, -1)", migrationLockName).Scan(&acquired).Error; err != nil {
+				return err
+			}
+			if acquired != 1 {
+				return fmt.Errorf("failed to acquire migration lock %q", migrationLockName)
+			}
+			return nil
+		},
+		unlock: func(conn *gorm.DB) error {
+			return conn.Exec("SELECT RELEASE_LOCK(?)", migrationLockName).Error
+		},
+	},
+	DriverSQLite: {
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INTEGER PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at DATETIME NOT NULL
+)`,
+		// SQLite serialises writers itself, and Connect keeps a single connection
+		lock:   func(conn *gorm.DB) error { return nil },
+		unlock: func(conn *gorm.DB) error { return nil },
+	},
+}
+
+// Migrator applies and reverts the embedded migrations of one driver. Every
+// operation holds a database-wide lock, and each migration runs in its own
+// transaction. MySQL commits DDL implicitly, so a failed MySQL migration can
+// leave part of its changes behind.
+type Migrator struct {
+	db         *gorm.DB
+	dialect    migrationDialect
+	migrations []Migration // Oldest first
+}
+
+// NewMigrator creates a Migrator for the driver's embedded migrations
+func NewMigrator(db *gorm.DB, driver string) (*Migrator, error) {
+	dialect, ok := migrationDialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMigrationDriver, driver)
+	}
+	migrations, err := loadMigrations(migrationFiles, path.Join("migrations", driver))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in version order and returns those applied
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		done, err := m.appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			if err := m.apply(conn, migration); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts up to steps of the most recently applied migrations and
+// returns those reverted, newest first
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		done, err := m.appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			if err := m.revert(conn, migration); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status lists every embedded migration, and any applied migration the binary
+// no longer embeds, in version order
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		done, err := m.appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+			if row, ok := done[migration.Version]; ok {
+				appliedAt := row.AppliedAt
+				status.AppliedAt = &appliedAt
+				delete(done, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+		for _, row := range done {
+			appliedAt := row.AppliedAt
+			statuses = append(statuses, MigrationStatus{Version: row.Version, Name: row.Name, AppliedAt: &appliedAt, Missing: true})
+		}
+		return nil
+	})
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, err
+}
+
+// withLock runs fn on a single connection holding the migration lock, after
+// making sure the schema_migrations table exists
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := m.dialect.lock(conn); err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer func() { _ = m.dialect.unlock(conn) }()
+
+		if err := conn.Exec(m.dialect.createTable).Error; err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+		return fn(conn)
+	})
+}
+
+// appliedVersions returns the applied migrations by version
+func (m *Migrator) appliedVersions(conn *gorm.DB) (map[int64]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := conn.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	done := make(map[int64]schemaMigration, len(rows))
+	for _, row := range rows {
+		done[row.Version] = row
+	}
+	return done, nil
+}
+
+func (m *Migrator) apply(conn *gorm.DB, migration Migration) error {
+	err := conn.Transaction(func(tx *gorm.DB) error {
+		if err := execStatements(tx, migration.Up); err != nil {
+			return err
+		}
+		return tx.Create(&schemaMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now().UTC(),
+		}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply migration %04d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+func (m *Migrator) revert(conn *gorm.DB, migration Migration) error {
+	err := conn.Transaction(func(tx *gorm.DB) error {
+		if err := execStatements(tx, migration.Down); err != nil {
+			return err
+		}
+		return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revert migration %04d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// execStatements runs a migration script one statement at a time, so drivers
+// without multi-statement support can run it too. Statements end with a
+// semicolon at the end of a line.
+func execStatements(tx *gorm.DB, script string) error {
+	for _, statement := range splitStatements(script) {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitStatements splits a script into statements, dropping comment lines
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
+
+// loadMigrations reads the migrations in dir, named
+// <version>_<name>.up.sql and <version>_<name>.down.sql. Every migration
+// needs both files.
+func loadMigrations(files fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		file := entry.Name()
+		base, direction, ok := cutMigrationSuffix(file)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidMigrationFile, file)
+		}
+		prefix, name, ok := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if !ok || err != nil || version <= 0 || name == "" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidMigrationFile, file)
+		}
+
+		content, err := fs.ReadFile(files, path.Join(dir, file))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		} else if migration.Name != name {
+			return nil, fmt.Errorf("%w: version %d is used by %s and %s", ErrInvalidMigrationFile, version, migration.Name, name)
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("%w: %04d_%s needs both up and down files", ErrInvalidMigrationFile, migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// cutMigrationSuffix splits a migration file name into its base and direction
+func cutMigrationSuffix(file string) (base, direction string, ok bool) {
+	for _, direction := range []string{"up", "down"} {
+		if base, found := strings.CutSuffix(file, "."+direction+".sql"); found {
+			return base, direction, true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,210 @@
+package database
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+// The baseline models are the entities as the first release stored them,
+// when AutoMigrate built the schema. BaseEntity has not changed since.
+type baselineUser struct {
+	entity.BaseEntity
+	Email    string `gorm:"uniqueIndex;not null;size:255"`
+	Password string `gorm:"not null;size:255"`
+	Name     string `gorm:"not null;size:255"`
+	Role     string `gorm:"type:varchar(20);default:'user'"`
+}
+
+type baselineTeam struct {
+	entity.BaseEntity
+	Name        string           `gorm:"not null;size:255"`
+	Logo        string           `gorm:"size:500"`
+	FoundedYear int              `gorm:"not null"`
+	Address     string           `gorm:"size:500"`
+	City        string           `gorm:"not null;size:100"`
+	Players     []baselinePlayer `gorm:"foreignKey:TeamID"`
+}
+
+type baselinePlayer struct {
+	entity.BaseEntity
+	TeamID       uuid.UUID     `gorm:"type:uuid;not null;index"`
+	Name         string        `gorm:"not null;size:255"`
+	Height       float64       `gorm:"not null"`
+	Weight       float64       `gorm:"not null"`
+	Position     string        `gorm:"type:varchar(20);not null"`
+	JerseyNumber int           `gorm:"not null"`
+	Team         *baselineTeam `gorm:"foreignKey:TeamID"`
+}
+
+type baselineMatch struct {
+	entity.BaseEntity
+	MatchDate  time.Time      `gorm:"not null;index"`
+	MatchTime  string         `gorm:"not null;size:10"`
+	HomeTeamID uuid.UUID      `gorm:"type:uuid;not null;index"`
+	AwayTeamID uuid.UUID      `gorm:"type:uuid;not null;index"`
+	HomeScore  *int           `gorm:"default:null"`
+	AwayScore  *int           `gorm:"default:null"`
+	Status     string         `gorm:"type:varchar(20);default:'scheduled'"`
+	HomeTeam   *baselineTeam  `gorm:"foreignKey:HomeTeamID"`
+	AwayTeam   *baselineTeam  `gorm:"foreignKey:AwayTeamID"`
+	Goals      []baselineGoal `gorm:"foreignKey:MatchID"`
+}
+
+type baselineGoal struct {
+	entity.BaseEntity
+	MatchID   uuid.UUID       `gorm:"type:uuid;not null;index"`
+	PlayerID  uuid.UUID       `gorm:"type:uuid;not null;index"`
+	TeamID    uuid.UUID       `gorm:"type:uuid;not null;index"`
+	Minute    int             `gorm:"not null"`
+	IsOwnGoal bool            `gorm:"default:false"`
+	Match     *baselineMatch  `gorm:"foreignKey:MatchID"`
+	Player    *baselinePlayer `gorm:"foreignKey:PlayerID"`
+	Team      *baselineTeam   `gorm:"foreignKey:TeamID"`
+}
+
+func (baselineUser) TableName() string   { return "users" }
+func (baselineTeam) TableName() string   { return "teams" }
+func (baselinePlayer) TableName() string { return "players" }
+func (baselineMatch) TableName() string  { return "matches" }
+func (baselineGoal) TableName() string   { return "goals" }
+
+// MySQL is left out: it has no uuid type, so AutoMigrate never built the
+// baseline schema there. Postgres runs when TEST_POSTGRES_DSN names a
+// disposable database.
+func TestMigrateBaselineDatabase(t *testing.T) {
+	t.Run(DriverSQLite, func(t *testing.T) {
+		db, err := Connect(&config.Config{
+			Server:   config.ServerConfig{Mode: "test"},
+			Database: config.DatabaseConfig{Driver: DriverSQLite, Name: ":memory:"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			if sqlDB, err := db.DB(); err == nil {
+				sqlDB.Close()
+			}
+		})
+		testMigrateBaseline(t, db, DriverSQLite)
+	})
+
+	t.Run(DriverPostgres, func(t *testing.T) {
+		dsn := os.Getenv("TEST_POSTGRES_DSN")
+		if dsn == "" {
+			t.Skip("TEST_POSTGRES_DSN is not set")
+		}
+		db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		testMigrateBaseline(t, db, DriverPostgres)
+	})
+}
+
+// testMigrateBaseline builds the baseline schema with a played match, then
+// checks the migrations bring it up to date and can be reverted
+func testMigrateBaseline(t *testing.T, db *gorm.DB, driver string) {
+	ctx := context.Background()
+	migrator, err := NewMigrator(db, driver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Down(ctx, math.MaxInt); err != nil {
+		t.Fatal(err)
+	}
+	baseline := []any{&baselineUser{}, &baselineTeam{}, &baselinePlayer{}, &baselineMatch{}, &baselineGoal{}}
+	if err := db.Migrator().DropTable(append(baseline, "schema_migrations")...); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(baseline...); err != nil {
+		t.Fatal(err)
+	}
+
+	home := baselineTeam{BaseEntity: entity.BaseEntity{ID: uuid.New()}, Name: "Persija Jakarta", FoundedYear: 1928, City: "Jakarta"}
+	away := baselineTeam{BaseEntity: entity.BaseEntity{ID: uuid.New()}, Name: "Persib Bandung", FoundedYear: 1933, City: "Bandung"}
+	scorer := baselinePlayer{BaseEntity: entity.BaseEntity{ID: uuid.New()}, TeamID: home.ID, Name: "Marko Simic", Height: 186.5, Weight: 80, Position: "forward", JerseyNumber: 9}
+	score, conceded := 1, 0
+	match := baselineMatch{
+		BaseEntity: entity.BaseEntity{ID: uuid.New()},
+		MatchDate:  time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+		MatchTime:  "15:30",
+		HomeTeamID: home.ID,
+		AwayTeamID: away.ID,
+		HomeScore:  &score,
+		AwayScore:  &conceded,
+		Status:     "completed",
+	}
+	goal := baselineGoal{BaseEntity: entity.BaseEntity{ID: uuid.New()}, MatchID: match.ID, PlayerID: scorer.ID, TeamID: home.ID, Minute: 67}
+	for _, record := range []any{&home, &away, &scorer, &match, &goal} {
+		if err := db.Omit(clause.Associations).Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(migrator.migrations) {
+		t.Fatalf("applied %d migrations, want all %d", len(applied), len(migrator.migrations))
+	}
+	requireSchema(t, db)
+
+	stored, err := NewMatchRepository(db).FindByIDWithDetails(ctx, match.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Period != entity.PeriodFullTime || stored.GetResult() != entity.ResultHomeWin || stored.HomeTeam == nil || stored.HomeTeam.Name != home.Name {
+		t.Fatalf("got %+v, want the baseline home win", stored)
+	}
+	if len(stored.Goals) != 1 || stored.Goals[0].StoppageTime != 0 || stored.Goals[0].IsPenalty || stored.Goals[0].Player == nil || stored.Goals[0].Player.Height != scorer.Height {
+		t.Fatalf("got goals %+v, want the baseline goal", stored.Goals)
+	}
+
+	// Reverting to the baseline keeps its data, and the upgrade runs again
+	if _, err := migrator.Down(ctx, len(migrator.migrations)-1); err != nil {
+		t.Fatal(err)
+	}
+	if db.Migrator().HasColumn(&entity.Match{}, "venue_id") || db.Migrator().HasTable(&entity.Venue{}) {
+		t.Fatal("reverting to the baseline kept the venues")
+	}
+	var count int64
+	if err := db.Model(&baselineGoal{}).Count(&count).Error; err != nil || count != 1 {
+		t.Fatalf("got %d baseline goals, %v, want 1", count, err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	requireSchema(t, db)
+}
+
+// requireSchema fails unless every column of every model exists
+func requireSchema(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			t.Fatal(err)
+		}
+		if !db.Migrator().HasTable(model) {
+			t.Errorf("table %s is missing", stmt.Schema.Table)
+			continue
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName != "" && !db.Migrator().HasColumn(model, field.DBName) {
+				t.Errorf("column %s.%s is missing", stmt.Schema.Table, field.DBName)
+			}
+		}
+	}
+}
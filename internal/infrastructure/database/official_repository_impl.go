@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// officialRoleOrder orders a match's officials referee first, then the
+// assistants and the fourth official
+const officialRoleOrder = "CASE match_officials.role WHEN 'referee' THEN 0 WHEN 'assistant_1' THEN 1 WHEN 'assistant_2' THEN 2 ELSE 3 END"
+
+type officialRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewOfficialRepository creates a new instance of OfficialRepository
+func NewOfficialRepository(db *gorm.DB) repository.OfficialRepository {
+	return &officialRepositoryImpl{db: db}
+}
+
+func (r *officialRepositoryImpl) Create(ctx context.Context, official *entity.Official) error {
+	return getDB(ctx, r.db).Omit("User").Create(official).Error
+}
+
+func (r *officialRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Official, error) {
+	var official entity.Official
+	err := getDB(ctx, r.db).First(&official, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
+
+func (r *officialRepositoryImpl) FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.Official, error) {
+	var official entity.Official
+	err := getDB(ctx, r.db).First(&official, "user_id = ?", userID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
+
+func (r *officialRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Official, error) {
+	var officials []entity.Official
+	err := getDB(ctx, r.db).Where("id IN ?", ids).Find(&officials).Error
+	return officials, err
+}
+
+func (r *officialRepositoryImpl) Update(ctx context.Context, official *entity.Official) error {
+	return getDB(ctx, r.db).Omit("User").Save(official).Error
+}
+
+func (r *officialRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Delete(&entity.Official{}, "id = ?", id).Error
+}
+
+func (r *officialRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Official, int64, error) {
+	var officials []entity.Official
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := getDB(ctx, r.db).Model(&entity.Official{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = getDB(ctx, r.db).
+		Offset(offset).
+		Limit(limit).
+		Order("name ASC").
+		Find(&officials).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return officials, total, nil
+}
+
+func (r *officialRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := getDB(ctx, r.db).
+		Model(&entity.Official{}).
+		Where("id = ?", id).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *officialRepositoryImpl) FindAssignmentsByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.MatchOfficial, error) {
+	var assignments []entity.MatchOfficial
+	err := getDB(ctx, r.db).
+		Preload("Official").
+		Where("match_id = ?", matchID).
+		Order(officialRoleOrder).
+		Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *officialRepositoryImpl) FindAssignmentsByOfficialID(ctx context.Context, officialID uuid.UUID, startDate, endDate *time.Time) ([]entity.MatchOfficial, error) {
+	var assignments []entity.MatchOfficial
+	query := getDB(ctx, r.db).
+		Preload("Match").
+		Preload("Match.HomeTeam").
+		Preload("Match.AwayTeam").
+		Preload("Match.Venue").
+		Joins("JOIN matches ON matches.id = match_officials.match_id AND matches.deleted_at IS NULL").
+		Where("match_officials.official_id = ?", officialID)
+	if startDate != nil {
+		query = query.Where("matches.match_date >= ?", *startDate)
+	}
+	if endDate != nil {
+		query = query.Where("matches.match_date <= ?", *endDate)
+	}
+	err := query.
+		Order("matches.match_date ASC, matches.match_time ASC").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *officialRepositoryImpl) FindAssignmentsOnDate(ctx context.Context, officialIDs []uuid.UUID, date time.Time, excludeMatchID uuid.UUID) ([]entity.MatchOfficial, error) {
+	var assignments []entity.MatchOfficial
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	err := getDB(ctx, r.db).
+		Preload("Match").
+		Joins("JOIN matches ON matches.id = match_officials.match_id AND matches.deleted_at IS NULL").
+		Where("match_officials.official_id IN ?", officialIDs).
+		Where("matches.match_date >= ? AND matches.match_date < ?", day, day.AddDate(0, 0, 1)).
+		Where("matches.status <> ?", entity.MatchStatusCancelled).
+		Where("match_officials.match_id <> ?", excludeMatchID).
+		Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *officialRepositoryImpl) CreateAssignments(ctx context.Context, assignments []entity.MatchOfficial) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+	return getDB(ctx, r.db).Omit("Match", "Official").Create(&assignments).Error
+}
+
+func (r *officialRepositoryImpl) UpdateAssignment(ctx context.Context, assignment *entity.MatchOfficial) error {
+	return getDB(ctx, r.db).Omit("Match", "Official").Save(assignment).Error
+}
+
+func (r *officialRepositoryImpl) DeleteAssignmentsByMatchID(ctx context.Context, matchID uuid.UUID) error {
+	return getDB(ctx, r.db).Delete(&entity.MatchOfficial{}, "match_id = ?", matchID).Error
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// activeAvailabilityCondition matches the availability records that cover
+// @date, mirroring entity.PlayerAvailability.IsActiveOn
+const activeAvailabilityCondition = "player_availabilities.start_date <= @date AND (" +
+	"(player_availabilities.end_date IS NOT NULL AND player_availabilities.end_date > @date) OR " +
+	"(player_availabilities.end_date IS NULL AND (player_availabilities.expected_return IS NULL OR player_availabilities.expected_return > @date)))"
+
+type playerAvailabilityRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPlayerAvailabilityRepository creates a new instance of PlayerAvailabilityRepository
+func NewPlayerAvailabilityRepository(db *gorm.DB) repository.PlayerAvailabilityRepository {
+	return &playerAvailabilityRepositoryImpl{db: db}
+}
+
+func (r *playerAvailabilityRepositoryImpl) Create(ctx context.Context, availability *entity.PlayerAvailability) error {
+	return getDB(ctx, r.db).Create(availability).Error
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.PlayerAvailability, error) {
+	var availability entity.PlayerAvailability
+	err := getDB(ctx, r.db).First(&availability, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+func (r *playerAvailabilityRepositoryImpl) Update(ctx context.Context, availability *entity.PlayerAvailability) error {
+	return getDB(ctx, r.db).Save(availability).Error
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.PlayerAvailability, error) {
+	var availabilities []entity.PlayerAvailability
+	err := getDB(ctx, r.db).
+		Where("player_id = ?", playerID).
+		Order("start_date DESC, created_at DESC").
+		Find(&availabilities).Error
+	return availabilities, err
+}
+
+func (r *playerAvailabilityRepositoryImpl) FindActiveByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time) ([]entity.PlayerAvailability, error) {
+	var availabilities []entity.PlayerAvailability
+	err := getDB(ctx, r.db).
+		Preload("Player").
+		Where("player_id IN (?)", getDB(ctx, r.db).Model(&entity.Player{}).Select("id").Where("team_id = ?", teamID)).
+		Where(activeAvailabilityCondition, sql.Named("date", date)).
+		Order("start_date ASC").
+		Find(&availabilities).Error
+	return availabilities, err
+}
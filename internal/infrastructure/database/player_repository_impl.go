@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
@@ -19,12 +21,12 @@ func NewPlayerRepository(db *gorm.DB) repository.PlayerRepository {
 }
 
 func (r *playerRepositoryImpl) Create(ctx context.Context, player *entity.Player) error {
-	return r.db.WithContext(ctx).Create(player).Error
+	return getDB(ctx, r.db).Create(player).Error
 }
 
 func (r *playerRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
 	var player entity.Player
-	err := r.db.WithContext(ctx).First(&player, "id = ?", id).Error
+	err := getDB(ctx, r.db).First(&player, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +35,7 @@ func (r *playerRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*ent
 
 func (r *playerRepositoryImpl) FindByIDWithTeam(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
 	var player entity.Player
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Preload("Team").
 		First(&player, "id = ?", id).Error
 	if err != nil {
@@ -43,11 +45,11 @@ func (r *playerRepositoryImpl) FindByIDWithTeam(ctx context.Context, id uuid.UUI
 }
 
 func (r *playerRepositoryImpl) Update(ctx context.Context, player *entity.Player) error {
-	return r.db.WithContext(ctx).Save(player).Error
+	return getDB(ctx, r.db).Save(player).Error
 }
 
 func (r *playerRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Player{}, "id = ?", id).Error
+	return getDB(ctx, r.db).Delete(&entity.Player{}, "id = ?", id).Error
 }
 
 func (r *playerRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error) {
@@ -56,12 +58,12 @@ func (r *playerRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).Model(&entity.Player{}).Count(&total).Error
+	err := getDB(ctx, r.db).Model(&entity.Player{}).Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Preload("Team").
 		Offset(offset).
 		Limit(limit).
@@ -80,7 +82,7 @@ func (r *playerRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUI
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Model(&entity.Player{}).
 		Where("team_id = ?", teamID).
 		Count(&total).Error
@@ -88,7 +90,7 @@ func (r *playerRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUI
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Where("team_id = ?", teamID).
 		Offset(offset).
 		Limit(limit).
@@ -101,9 +103,43 @@ func (r *playerRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUI
 	return players, total, nil
 }
 
+func (r *playerRepositoryImpl) FindAvailableByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time, page, limit int) ([]entity.Player, int64, error) {
+	var players []entity.Player
+	var total int64
+
+	offset := (page - 1) * limit
+	unavailable := getDB(ctx, r.db).
+		Model(&entity.PlayerAvailability{}).
+		Select("1").
+		Where("player_availabilities.player_id = players.id").
+		Where(activeAvailabilityCondition, sql.Named("date", date))
+
+	err := getDB(ctx, r.db).
+		Model(&entity.Player{}).
+		Where("team_id = ?", teamID).
+		Where("NOT EXISTS (?)", unavailable).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = getDB(ctx, r.db).
+		Where("team_id = ?", teamID).
+		Where("NOT EXISTS (?)", unavailable).
+		Offset(offset).
+		Limit(limit).
+		Order("jersey_number ASC").
+		Find(&players).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return players, total, nil
+}
+
 func (r *playerRepositoryImpl) IsJerseyNumberTaken(ctx context.Context, teamID uuid.UUID, jerseyNumber int, excludePlayerID *uuid.UUID) (bool, error) {
 	var count int64
-	query := r.db.WithContext(ctx).
+	query := getDB(ctx, r.db).
 		Model(&entity.Player{}).
 		Where("team_id = ? AND jersey_number = ?", teamID, jerseyNumber)
 
@@ -122,17 +158,18 @@ func (r *playerRepositoryImpl) Search(ctx context.Context, query string, page, l
 	offset := (page - 1) * limit
 	searchQuery := "%" + query + "%"
 
-	err := r.db.WithContext(ctx).
+	db := getDB(ctx, r.db)
+	err := db.
 		Model(&entity.Player{}).
-		Where("name ILIKE ?", searchQuery).
+		Where(containsInsensitive(db, "name"), searchQuery).
 		Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = db.
 		Preload("Team").
-		Where("name ILIKE ?", searchQuery).
+		Where(containsInsensitive(db, "name"), searchQuery).
 		Offset(offset).
 		Limit(limit).
 		Order("created_at DESC").
@@ -146,7 +183,7 @@ func (r *playerRepositoryImpl) Search(ctx context.Context, query string, page, l
 
 func (r *playerRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Model(&entity.Player{}).
 		Where("id = ?", id).
 		Count(&count).Error
@@ -154,17 +191,43 @@ func (r *playerRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool,
 }
 
 func (r *playerRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.PlayerGoalCount, error) {
-	var results []repository.PlayerGoalCount
+	db := getDB(ctx, r.db)
 
-	err := r.db.WithContext(ctx).
+	// Count first, then load the players, since GORM cannot scan a joined
+	// row into the nested Player
+	var counts []struct {
+		PlayerID  uuid.UUID
+		GoalCount int64
+	}
+	err := db.
 		Table("goals").
-		Select("players.*, COUNT(goals.id) as goal_count").
+		Select("goals.player_id, COUNT(goals.id) as goal_count").
 		Joins("JOIN players ON players.id = goals.player_id").
 		Where("goals.deleted_at IS NULL AND players.deleted_at IS NULL").
-		Group("players.id").
+		Group("goals.player_id").
 		Order("goal_count DESC").
 		Limit(limit).
-		Scan(&results).Error
+		Scan(&counts).Error
+	if err != nil || len(counts) == 0 {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(counts))
+	for i, count := range counts {
+		ids[i] = count.PlayerID
+	}
+	var players []entity.Player
+	if err := db.Where("id IN ?", ids).Find(&players).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]entity.Player, len(players))
+	for _, player := range players {
+		byID[player.ID] = player
+	}
 
-	return results, err
+	results := make([]repository.PlayerGoalCount, len(counts))
+	for i, count := range counts {
+		results[i] = repository.PlayerGoalCount{Player: byID[count.PlayerID], GoalCount: count.GoalCount}
+	}
+	return results, nil
 }
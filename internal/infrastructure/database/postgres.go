@@ -1,10 +1,12 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 
+	"github.com/glebarez/sqlite"
 	"github.com/zenkriztao/ayo-football-backend/internal/config"
 	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
 	"gorm.io/driver/mysql"
@@ -13,12 +15,53 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-// NewDatabase creates a new database connection based on configuration
+// Schema modes for DB_MIGRATE
+const (
+	MigrateUp   = "up"   // Apply pending versioned migrations
+	MigrateAuto = "auto" // GORM AutoMigrate, for development only
+	MigrateNone = "none" // Leave the schema alone
+)
+
+// NewDatabase creates a new database connection based on configuration and
+// prepares the schema as cfg.Database.Migrate asks
 func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Database.Migrate {
+	case MigrateUp:
+		migrator, err := NewMigrator(db, cfg.Database.Driver)
+		if err != nil {
+			return nil, err
+		}
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate: %w", err)
+		}
+		log.Printf("Database migrations applied: %d", len(applied))
+	case MigrateAuto:
+		log.Println("Running GORM AutoMigrate, use versioned migrations outside development")
+		if err := autoMigrate(db); err != nil {
+			return nil, fmt.Errorf("failed to auto migrate: %w", err)
+		}
+	case MigrateNone:
+	default:
+		return nil, fmt.Errorf("unsupported DB_MIGRATE mode: %s", cfg.Database.Migrate)
+	}
+
+	log.Println("Database connection established successfully")
+	return db, nil
+}
+
+// Connect opens a database connection based on configuration without
+// touching the schema
+func Connect(cfg *config.Config) (*gorm.DB, error) {
 	var dialector gorm.Dialector
 
 	switch cfg.Database.Driver {
-	case "postgres":
+	case DriverPostgres:
 		// Check for DATABASE_URL first (Railway provides this)
 		dsn := os.Getenv("DATABASE_URL")
 		if dsn == "" {
@@ -45,7 +88,7 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 		}
 		log.Printf("Connecting to database...")
 		dialector = postgres.Open(dsn)
-	case "mysql":
+	case DriverMySQL:
 		dsn := fmt.Sprintf(
 			"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 			cfg.Database.User,
@@ -55,6 +98,8 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 			cfg.Database.Name,
 		)
 		dialector = mysql.Open(dsn)
+	case DriverSQLite:
+		dialector = sqlite.Open(sqliteDSN(cfg.Database.Name))
 	default:
 		return nil, fmt.Errorf("unsupported database driver: %s", cfg.Database.Driver)
 	}
@@ -71,22 +116,56 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	// Auto migrate entities
-	if err := autoMigrate(db); err != nil {
-		return nil, fmt.Errorf("failed to auto migrate: %w", err)
+	if cfg.Database.Driver == DriverSQLite {
+		// SQLite allows one writer at a time and an in-memory database only
+		// lives as long as its connection, so every query shares one
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		sqlDB.SetMaxOpenConns(1)
 	}
-
-	log.Println("Database connection established successfully")
 	return db, nil
 }
 
-// autoMigrate runs auto migration for all entities
+// sqliteDSN returns the DSN of the SQLite database file name, or of an
+// in-memory database for ":memory:", with foreign keys enforced. The driver
+// is pure Go, so builds without cgo support SQLite too.
+func sqliteDSN(name string) string {
+	if name == ":memory:" {
+		return "file::memory:?_pragma=foreign_keys(1)"
+	}
+	return "file:" + name + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+}
+
+// models are the entities stored in the database
+var models = []any{
+	&entity.User{},
+	&entity.Venue{},
+	&entity.Team{},
+	&entity.Player{},
+	&entity.Transfer{},
+	&entity.PlayerAvailability{},
+	&entity.Competition{},
+	&entity.Season{},
+	&entity.Group{},
+	&entity.GroupTeam{},
+	&entity.Match{},
+	&entity.Goal{},
+	&entity.MatchEvent{},
+	&entity.Lineup{},
+	&entity.LineupPlayer{},
+	&entity.Official{},
+	&entity.MatchOfficial{},
+	&entity.Suspension{},
+	&entity.Bracket{},
+	&entity.BracketTie{},
+	&entity.TeamRating{},
+	&entity.RatingHistory{},
+}
+
+// autoMigrate runs auto migration for all entities. New schema changes also
+// need a versioned migration under migrations/.
 func autoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&entity.User{},
-		&entity.Team{},
-		&entity.Player{},
-		&entity.Match{},
-		&entity.Goal{},
-	)
+	return db.AutoMigrate(models...)
 }
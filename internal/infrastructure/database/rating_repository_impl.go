@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// ratingHistoryBatchSize keeps a full replay's inserts under the database's
+// bind parameter limit
+const ratingHistoryBatchSize = 500
+
+type ratingRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewRatingRepository creates a new instance of RatingRepository
+func NewRatingRepository(db *gorm.DB) repository.RatingRepository {
+	return &ratingRepositoryImpl{db: db}
+}
+
+func (r *ratingRepositoryImpl) FindAll(ctx context.Context) ([]entity.TeamRating, error) {
+	var ratings []entity.TeamRating
+	err := getDB(ctx, r.db).
+		Preload("Team").
+		Order("rating DESC").
+		Find(&ratings).Error
+	return ratings, err
+}
+
+func (r *ratingRepositoryImpl) FindByTeamIDs(ctx context.Context, teamIDs []uuid.UUID) ([]entity.TeamRating, error) {
+	var ratings []entity.TeamRating
+	if len(teamIDs) == 0 {
+		return ratings, nil
+	}
+	err := getDB(ctx, r.db).
+		Where("team_id IN ?", teamIDs).
+		Find(&ratings).Error
+	return ratings, err
+}
+
+func (r *ratingRepositoryImpl) FindHistoryByTeamID(ctx context.Context, teamID uuid.UUID) ([]entity.RatingHistory, error) {
+	var history []entity.RatingHistory
+	err := getDB(ctx, r.db).
+		Preload("Match").
+		Preload("Opponent").
+		Joins("JOIN matches ON matches.id = rating_histories.match_id").
+		Where("rating_histories.team_id = ?", teamID).
+		Order("matches.match_date ASC, matches.match_time ASC").
+		Find(&history).Error
+	return history, err
+}
+
+func (r *ratingRepositoryImpl) FindLatestHistory(ctx context.Context) (*entity.RatingHistory, error) {
+	var history entity.RatingHistory
+	err := getDB(ctx, r.db).
+		Preload("Match").
+		Joins("JOIN matches ON matches.id = rating_histories.match_id").
+		Order("matches.match_date DESC, matches.match_time DESC, matches.id DESC").
+		First(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+func (r *ratingRepositoryImpl) Apply(ctx context.Context, ratings []entity.TeamRating, history []entity.RatingHistory) error {
+	return getDB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for i := range ratings {
+			if err := tx.Save(&ratings[i]).Error; err != nil {
+				return err
+			}
+		}
+		if len(history) == 0 {
+			return nil
+		}
+		return tx.Create(&history).Error
+	})
+}
+
+func (r *ratingRepositoryImpl) ReplaceAll(ctx context.Context, ratings []entity.TeamRating, history []entity.RatingHistory) error {
+	return getDB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Ratings are derived data, so old rows are removed for good rather
+		// than soft deleted
+		if err := tx.Unscoped().Where("1 = 1").Delete(&entity.RatingHistory{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("1 = 1").Delete(&entity.TeamRating{}).Error; err != nil {
+			return err
+		}
+		if len(ratings) > 0 {
+			if err := tx.Create(&ratings).Error; err != nil {
+				return err
+			}
+		}
+		if len(history) > 0 {
+			if err := tx.CreateInBatches(&history, ratingHistoryBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,94 @@
+package database_test
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/config"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository/repositorytest"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/database"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The SQLite contract always runs. The server databases run when a DSN for a
+// disposable database is set, since every test drops and recreates its schema:
+//
+//	TEST_POSTGRES_DSN=postgres://postgres@localhost:5432/ayo_test?sslmode=disable
+//	TEST_MYSQL_DSN=root:secret@tcp(localhost:3306)/ayo_test?parseTime=True&loc=UTC
+func TestRepositoryContract(t *testing.T) {
+	t.Run(database.DriverSQLite, func(t *testing.T) {
+		repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+			cfg := &config.Config{
+				Server:   config.ServerConfig{Mode: "test"},
+				Database: config.DatabaseConfig{Driver: database.DriverSQLite, Name: ":memory:"},
+			}
+			db, err := database.Connect(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			migrate(t, db, database.DriverSQLite)
+			t.Cleanup(func() {
+				if sqlDB, err := db.DB(); err == nil {
+					sqlDB.Close()
+				}
+			})
+			return newRepositories(db)
+		})
+	})
+
+	servers := []struct {
+		driver string
+		env    string
+		open   func(dsn string) gorm.Dialector
+	}{
+		{database.DriverPostgres, "TEST_POSTGRES_DSN", postgres.Open},
+		{database.DriverMySQL, "TEST_MYSQL_DSN", mysql.Open},
+	}
+	for _, server := range servers {
+		t.Run(server.driver, func(t *testing.T) {
+			dsn := os.Getenv(server.env)
+			if dsn == "" {
+				t.Skipf("%s is not set", server.env)
+			}
+			db, err := gorm.Open(server.open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+				migrate(t, db, server.driver)
+				return newRepositories(db)
+			})
+		})
+	}
+}
+
+// migrate drops the schema, if any, and applies every migration
+func migrate(t *testing.T, db *gorm.DB, driver string) {
+	t.Helper()
+	ctx := context.Background()
+	migrator, err := database.NewMigrator(db, driver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Down(ctx, math.MaxInt); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newRepositories(db *gorm.DB) repositorytest.Repositories {
+	return repositorytest.Repositories{
+		Users:   database.NewUserRepository(db),
+		Teams:   database.NewTeamRepository(db),
+		Players: database.NewPlayerRepository(db),
+		Matches: database.NewMatchRepository(db),
+		Goals:   database.NewGoalRepository(db),
+	}
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type seasonRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSeasonRepository creates a new instance of SeasonRepository
+func NewSeasonRepository(db *gorm.DB) repository.SeasonRepository {
+	return &seasonRepositoryImpl{db: db}
+}
+
+func (r *seasonRepositoryImpl) Create(ctx context.Context, season *entity.Season) error {
+	return getDB(ctx, r.db).Create(season).Error
+}
+
+func (r *seasonRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Season, error) {
+	var season entity.Season
+	err := getDB(ctx, r.db).First(&season, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &season, nil
+}
+
+func (r *seasonRepositoryImpl) Update(ctx context.Context, season *entity.Season) error {
+	return getDB(ctx, r.db).Save(season).Error
+}
+
+func (r *seasonRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Delete(&entity.Season{}, "id = ?", id).Error
+}
+
+func (r *seasonRepositoryImpl) FindByCompetitionID(ctx context.Context, competitionID uuid.UUID, page, limit int) ([]entity.Season, int64, error) {
+	var seasons []entity.Season
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := getDB(ctx, r.db).
+		Model(&entity.Season{}).
+		Where("competition_id = ?", competitionID).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = getDB(ctx, r.db).
+		Where("competition_id = ?", competitionID).
+		Offset(offset).
+		Limit(limit).
+		Order("start_date DESC").
+		Find(&seasons).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return seasons, total, nil
+}
+
+func (r *seasonRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := getDB(ctx, r.db).
+		Model(&entity.Season{}).
+		Where("id = ?", id).
+		Count(&count).Error
+	return count > 0, err
+}
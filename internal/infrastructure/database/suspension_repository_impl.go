@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type suspensionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSuspensionRepository creates a new instance of SuspensionRepository
+func NewSuspensionRepository(db *gorm.DB) repository.SuspensionRepository {
+	return &suspensionRepositoryImpl{db: db}
+}
+
+func (r *suspensionRepositoryImpl) ReplaceForPlayer(ctx context.Context, playerID uuid.UUID, suspensions []entity.Suspension) error {
+	return getDB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entity.Suspension{}, "player_id = ?", playerID).Error; err != nil {
+			return err
+		}
+		if len(suspensions) == 0 {
+			return nil
+		}
+		return tx.Create(&suspensions).Error
+	})
+}
+
+func (r *suspensionRepositoryImpl) FindByPlayerIDs(ctx context.Context, playerIDs []uuid.UUID) ([]entity.Suspension, error) {
+	var suspensions []entity.Suspension
+	if len(playerIDs) == 0 {
+		return suspensions, nil
+	}
+	err := r.withDetails(ctx).
+		Where("player_id IN ?", playerIDs).
+		Find(&suspensions).Error
+	return suspensions, err
+}
+
+func (r *suspensionRepositoryImpl) FindAll(ctx context.Context, teamID *uuid.UUID) ([]entity.Suspension, error) {
+	var suspensions []entity.Suspension
+	query := r.withDetails(ctx)
+	if teamID != nil {
+		query = query.Where("team_id = ?", *teamID)
+	}
+	err := query.Order("created_at ASC").Find(&suspensions).Error
+	return suspensions, err
+}
+
+// withDetails preloads the player, the team and the match the ban came from
+func (r *suspensionRepositoryImpl) withDetails(ctx context.Context) *gorm.DB {
+	return getDB(ctx, r.db).
+		Preload("Player").
+		Preload("Team").
+		Preload("Match")
+}
@@ -19,12 +19,12 @@ func NewTeamRepository(db *gorm.DB) repository.TeamRepository {
 }
 
 func (r *teamRepositoryImpl) Create(ctx context.Context, team *entity.Team) error {
-	return r.db.WithContext(ctx).Create(team).Error
+	return getDB(ctx, r.db).Create(team).Error
 }
 
 func (r *teamRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
 	var team entity.Team
-	err := r.db.WithContext(ctx).First(&team, "id = ?", id).Error
+	err := getDB(ctx, r.db).First(&team, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +33,7 @@ func (r *teamRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entit
 
 func (r *teamRepositoryImpl) FindByIDWithPlayers(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
 	var team entity.Team
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Preload("Players").
 		First(&team, "id = ?", id).Error
 	if err != nil {
@@ -43,11 +43,11 @@ func (r *teamRepositoryImpl) FindByIDWithPlayers(ctx context.Context, id uuid.UU
 }
 
 func (r *teamRepositoryImpl) Update(ctx context.Context, team *entity.Team) error {
-	return r.db.WithContext(ctx).Save(team).Error
+	return getDB(ctx, r.db).Save(team).Error
 }
 
 func (r *teamRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Team{}, "id = ?", id).Error
+	return getDB(ctx, r.db).Delete(&entity.Team{}, "id = ?", id).Error
 }
 
 func (r *teamRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Team, int64, error) {
@@ -56,12 +56,12 @@ func (r *teamRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]en
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).Model(&entity.Team{}).Count(&total).Error
+	err := getDB(ctx, r.db).Model(&entity.Team{}).Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Offset(offset).
 		Limit(limit).
 		Order("created_at DESC").
@@ -80,16 +80,19 @@ func (r *teamRepositoryImpl) Search(ctx context.Context, query string, page, lim
 	offset := (page - 1) * limit
 	searchQuery := "%" + query + "%"
 
-	err := r.db.WithContext(ctx).
+	db := getDB(ctx, r.db)
+	condition := containsInsensitive(db, "name") + " OR " + containsInsensitive(db, "city")
+
+	err := db.
 		Model(&entity.Team{}).
-		Where("name ILIKE ? OR city ILIKE ?", searchQuery, searchQuery).
+		Where(condition, searchQuery, searchQuery).
 		Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
-		Where("name ILIKE ? OR city ILIKE ?", searchQuery, searchQuery).
+	err = db.
+		Where(condition, searchQuery, searchQuery).
 		Offset(offset).
 		Limit(limit).
 		Order("created_at DESC").
@@ -103,7 +106,7 @@ func (r *teamRepositoryImpl) Search(ctx context.Context, query string, page, lim
 
 func (r *teamRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
+	err := getDB(ctx, r.db).
 		Model(&entity.Team{}).
 		Where("id = ?", id).
 		Count(&count).Error
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type transferRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTransferRepository creates a new instance of TransferRepository
+func NewTransferRepository(db *gorm.DB) repository.TransferRepository {
+	return &transferRepositoryImpl{db: db}
+}
+
+func (r *transferRepositoryImpl) Create(ctx context.Context, transfer *entity.Transfer) error {
+	return getDB(ctx, r.db).Create(transfer).Error
+}
+
+func (r *transferRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID) ([]entity.Transfer, error) {
+	var transfers []entity.Transfer
+	err := getDB(ctx, r.db).
+		Preload("FromTeam").
+		Preload("ToTeam").
+		Where("player_id = ?", playerID).
+		Order("transfer_date ASC, created_at ASC").
+		Find(&transfers).Error
+	return transfers, err
+}
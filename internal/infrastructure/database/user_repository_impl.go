@@ -19,12 +19,12 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 }
 
 func (r *userRepositoryImpl) Create(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	return getDB(ctx, r.db).Create(user).Error
 }
 
 func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	var user entity.User
-	err := r.db.WithContext(ctx).First(&user, "id = ?", id).Error
+	err := getDB(ctx, r.db).First(&user, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +33,7 @@ func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entit
 
 func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	err := r.db.WithContext(ctx).First(&user, "email = ?", email).Error
+	err := getDB(ctx, r.db).First(&user, "email = ?", email).Error
 	if err != nil {
 		return nil, err
 	}
@@ -41,11 +41,11 @@ func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*en
 }
 
 func (r *userRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Save(user).Error
+	return getDB(ctx, r.db).Save(user).Error
 }
 
 func (r *userRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.User{}, "id = ?", id).Error
+	return getDB(ctx, r.db).Delete(&entity.User{}, "id = ?", id).Error
 }
 
 func (r *userRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.User, int64, error) {
@@ -54,12 +54,12 @@ func (r *userRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]en
 
 	offset := (page - 1) * limit
 
-	err := r.db.WithContext(ctx).Model(&entity.User{}).Count(&total).Error
+	err := getDB(ctx, r.db).Model(&entity.User{}).Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = getDB(ctx, r.db).
 		Offset(offset).
 		Limit(limit).
 		Order("created_at DESC").
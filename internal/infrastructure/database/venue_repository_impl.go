@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type venueRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewVenueRepository creates a new instance of VenueRepository
+func NewVenueRepository(db *gorm.DB) repository.VenueRepository {
+	return &venueRepositoryImpl{db: db}
+}
+
+func (r *venueRepositoryImpl) Create(ctx context.Context, venue *entity.Venue) error {
+	return getDB(ctx, r.db).Create(venue).Error
+}
+
+func (r *venueRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Venue, error) {
+	var venue entity.Venue
+	err := getDB(ctx, r.db).First(&venue, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+func (r *venueRepositoryImpl) Update(ctx context.Context, venue *entity.Venue) error {
+	return getDB(ctx, r.db).Save(venue).Error
+}
+
+func (r *venueRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return getDB(ctx, r.db).Delete(&entity.Venue{}, "id = ?", id).Error
+}
+
+func (r *venueRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Venue, int64, error) {
+	var venues []entity.Venue
+	var total int64
+
+	offset := (page - 1) * limit
+
+	err := getDB(ctx, r.db).Model(&entity.Venue{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = getDB(ctx, r.db).
+		Offset(offset).
+		Limit(limit).
+		Order("name ASC").
+		Find(&venues).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return venues, total, nil
+}
+
+func (r *venueRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := getDB(ctx, r.db).
+		Model(&entity.Venue{}).
+		Where("id = ?", id).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+)
+
+// The Add builders store a valid record, for test fixtures and demo data.
+// Each fills in the required fields, applies the changes given and returns
+// the stored record.
+
+// AddTeam stores a team
+func (s *Store) AddTeam(name, city string, changes ...func(*entity.Team)) *entity.Team {
+	team := &entity.Team{Name: name, City: city, FoundedYear: 2000}
+	for _, change := range changes {
+		change(team)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.create(&team.BaseEntity)
+	s.putTeam(team)
+	return team
+}
+
+// AddPlayer stores a forward of the team
+func (s *Store) AddPlayer(team *entity.Team, name string, jerseyNumber int, changes ...func(*entity.Player)) *entity.Player {
+	player := &entity.Player{
+		TeamID:       team.ID,
+		Name:         name,
+		Height:       175,
+		Weight:       70,
+		Position:     entity.PositionForward,
+		JerseyNumber: jerseyNumber,
+	}
+	for _, change := range changes {
+		change(player)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.create(&player.BaseEntity)
+	s.putPlayer(player)
+	return player
+}
+
+// AddMatch stores a scheduled match kicking off at kickoff
+func (s *Store) AddMatch(home, away *entity.Team, kickoff time.Time, changes ...func(*entity.Match)) *entity.Match {
+	year, month, day := kickoff.Date()
+	match := &entity.Match{
+		MatchDate:  time.Date(year, month, day, 0, 0, 0, 0, kickoff.Location()),
+		MatchTime:  kickoff.Format("15:04"),
+		HomeTeamID: home.ID,
+		AwayTeamID: away.ID,
+		Status:     entity.MatchStatusScheduled,
+	}
+	for _, change := range changes {
+		change(match)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.create(&match.BaseEntity)
+	s.putMatch(match)
+	return match
+}
+
+// AddGoal stores a goal of the player in the match, for the player's team
+func (s *Store) AddGoal(match *entity.Match, player *entity.Player, minute int, changes ...func(*entity.Goal)) *entity.Goal {
+	goal := &entity.Goal{
+		MatchID:  match.ID,
+		PlayerID: player.ID,
+		TeamID:   player.TeamID,
+		Minute:   minute,
+	}
+	for _, change := range changes {
+		change(goal)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.create(&goal.BaseEntity)
+	s.putGoal(goal)
+	return goal
+}
+
+// AddUser stores a user with the role. It panics when the email is taken,
+// since the fixture could never be stored.
+func (s *Store) AddUser(email string, role entity.UserRole, changes ...func(*entity.User)) *entity.User {
+	user := &entity.User{Email: email, Name: email, Role: role}
+	for _, change := range changes {
+		change(user)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.users {
+		if existing.Email == user.Email {
+			panic(fmt.Sprintf("memory: user %s already exists", user.Email))
+		}
+	}
+	s.create(&user.BaseEntity)
+	s.users[user.ID] = *user
+	return user
+}
+
+// Completed marks a match completed with the final score
+func Completed(homeScore, awayScore int) func(*entity.Match) {
+	return func(match *entity.Match) {
+		match.Status = entity.MatchStatusCompleted
+		match.Period = entity.PeriodFullTime
+		match.HomeScore = &homeScore
+		match.AwayScore = &awayScore
+	}
+}
@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type goalRepositoryImpl struct {
+	store *Store
+}
+
+// NewGoalRepository creates a new instance of GoalRepository
+func NewGoalRepository(store *Store) repository.GoalRepository {
+	return &goalRepositoryImpl{store: store}
+}
+
+func goalBase(goal *entity.Goal) *entity.BaseEntity { return &goal.BaseEntity }
+
+// putGoal stores the goal without its associations
+func (s *Store) putGoal(goal *entity.Goal) {
+	record := *goal
+	record.Match = nil
+	record.Player = nil
+	record.Team = nil
+	s.goals[goal.ID] = record
+}
+
+// matchGoals returns the match's goals in the order they were scored, with
+// their scorers and teams. The caller holds the lock.
+func (s *Store) matchGoals(matchID uuid.UUID) []entity.Goal {
+	goals := live(s.goals, goalBase, func(goal *entity.Goal) bool {
+		return goal.MatchID == matchID
+	}, byMinute)
+	for i := range goals {
+		goals[i].Player = s.player(goals[i].PlayerID)
+		goals[i].Team = s.team(goals[i].TeamID)
+	}
+	return goals
+}
+
+func (r *goalRepositoryImpl) Create(ctx context.Context, goal *entity.Goal) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.create(&goal.BaseEntity)
+	r.store.putGoal(goal)
+	return nil
+}
+
+func (r *goalRepositoryImpl) CreateBatch(ctx context.Context, goals []entity.Goal) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i := range goals {
+		r.store.create(&goals[i].BaseEntity)
+		r.store.putGoal(&goals[i])
+	}
+	return nil
+}
+
+func (r *goalRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goal, ok := r.store.goals[id]
+	if !ok || goal.DeletedAt.Valid {
+		return nil, gorm.ErrRecordNotFound
+	}
+	goal.Player = r.store.player(goal.PlayerID)
+	goal.Team = r.store.team(goal.TeamID)
+	return &goal, nil
+}
+
+func (r *goalRepositoryImpl) Update(ctx context.Context, goal *entity.Goal) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	goal.UpdatedAt = r.store.now()
+	r.store.putGoal(goal)
+	return nil
+}
+
+func (r *goalRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.deleteWhere(func(goal *entity.Goal) bool { return goal.ID == id })
+}
+
+func (r *goalRepositoryImpl) FindByMatchID(ctx context.Context, matchID uuid.UUID) ([]entity.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.matchGoals(matchID), nil
+}
+
+func (r *goalRepositoryImpl) FindByPlayerID(ctx context.Context, playerID uuid.UUID, from, to *time.Time) ([]entity.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goals := live(r.store.goals, goalBase, func(goal *entity.Goal) bool {
+		match := r.store.match(goal.MatchID)
+		return goal.PlayerID == playerID && match != nil && between(match.MatchDate, from, to)
+	}, func(a, b *entity.Goal) int {
+		if c := earliestFirst(r.store.match(a.MatchID), r.store.match(b.MatchID)); c != 0 {
+			return c
+		}
+		return byMinute(a, b)
+	})
+
+	for i := range goals {
+		matches := []entity.Match{*r.store.match(goals[i].MatchID)}
+		r.store.loadTeams(matches)
+		goals[i].Match = &matches[0]
+		goals[i].Team = r.store.team(goals[i].TeamID)
+	}
+	return goals, nil
+}
+
+func (r *goalRepositoryImpl) DeleteByMatchID(ctx context.Context, matchID uuid.UUID) error {
+	return r.deleteWhere(func(goal *entity.Goal) bool { return goal.MatchID == matchID })
+}
+
+func (r *goalRepositoryImpl) GetTopScorers(ctx context.Context, seasonID *uuid.UUID, limit int) ([]repository.TopScorerResult, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	byPlayer := make(map[uuid.UUID]*repository.TopScorerResult)
+	for _, goal := range live(r.store.goals, goalBase, nil, nil) {
+		if goal.IsOwnGoal {
+			continue
+		}
+		player := r.store.player(goal.PlayerID)
+		if player == nil {
+			continue
+		}
+		team := r.store.team(player.TeamID)
+		if team == nil {
+			continue
+		}
+		if seasonID != nil {
+			match := r.store.match(goal.MatchID)
+			if match == nil || !inSeason(seasonID)(match) {
+				continue
+			}
+		}
+
+		result, ok := byPlayer[player.ID]
+		if !ok {
+			result = &repository.TopScorerResult{
+				PlayerID:   player.ID,
+				PlayerName: player.Name,
+				TeamID:     team.ID,
+				TeamName:   team.Name,
+			}
+			byPlayer[player.ID] = result
+		}
+		result.GoalCount++
+	}
+
+	results := make([]repository.TopScorerResult, 0, len(byPlayer))
+	for _, result := range byPlayer {
+		results = append(results, *result)
+	}
+	slices.SortFunc(results, func(a, b repository.TopScorerResult) int {
+		if c := cmp.Compare(b.GoalCount, a.GoalCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.PlayerID.String(), b.PlayerID.String())
+	})
+	return paginate(results, 1, limit), nil
+}
+
+// deleteWhere soft-deletes the goals that match accepts
+func (r *goalRepositoryImpl) deleteWhere(match func(*entity.Goal) bool) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, goal := range live(r.store.goals, goalBase, match, nil) {
+		r.store.softDelete(&goal.BaseEntity)
+		r.store.goals[goal.ID] = goal
+	}
+	return nil
+}
+
+// byMinute orders goals by the minute, and stoppage time, they were scored
+func byMinute(a, b *entity.Goal) int {
+	if c := cmp.Compare(a.Minute, b.Minute); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.StoppageTime, b.StoppageTime)
+}
@@ -0,0 +1,325 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type matchRepositoryImpl struct {
+	store *Store
+}
+
+// NewMatchRepository creates a new instance of MatchRepository. Venues,
+// seasons, lineups and match events are not held in memory, so those
+// associations are never loaded.
+func NewMatchRepository(store *Store) repository.MatchRepository {
+	return &matchRepositoryImpl{store: store}
+}
+
+func matchBase(match *entity.Match) *entity.BaseEntity { return &match.BaseEntity }
+
+// match returns a copy of the match, or nil when it is missing or deleted.
+// The caller holds the lock.
+func (s *Store) match(id uuid.UUID) *entity.Match {
+	match, ok := s.matches[id]
+	if !ok || match.DeletedAt.Valid {
+		return nil
+	}
+	return &match
+}
+
+// putMatch stores the match without its associations
+func (s *Store) putMatch(match *entity.Match) {
+	record := *match
+	record.HomeTeam = nil
+	record.AwayTeam = nil
+	record.Season = nil
+	record.Venue = nil
+	record.Goals = nil
+	record.Events = nil
+	s.matches[match.ID] = record
+}
+
+// loadTeams loads the home and away teams of the matches
+func (s *Store) loadTeams(matches []entity.Match) {
+	for i := range matches {
+		matches[i].HomeTeam = s.team(matches[i].HomeTeamID)
+		matches[i].AwayTeam = s.team(matches[i].AwayTeamID)
+	}
+}
+
+// loadGoals loads the goals of the matches with their scorers and teams
+func (s *Store) loadGoals(matches []entity.Match) {
+	for i := range matches {
+		matches[i].Goals = s.matchGoals(matches[i].ID)
+	}
+}
+
+func (r *matchRepositoryImpl) Create(ctx context.Context, match *entity.Match) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.create(&match.BaseEntity)
+	r.store.putMatch(match)
+	return nil
+}
+
+func (r *matchRepositoryImpl) CreateBatch(ctx context.Context, matches []entity.Match) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for i := range matches {
+		r.store.create(&matches[i].BaseEntity)
+		r.store.putMatch(&matches[i])
+	}
+	return nil
+}
+
+func (r *matchRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match := r.store.match(id)
+	if match == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return match, nil
+}
+
+func (r *matchRepositoryImpl) FindByIDWithDetails(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	match := r.store.match(id)
+	if match == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	matches := []entity.Match{*match}
+	r.store.loadTeams(matches)
+	r.store.loadGoals(matches)
+	return &matches[0], nil
+}
+
+func (r *matchRepositoryImpl) Update(ctx context.Context, match *entity.Match) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	match.UpdatedAt = r.store.now()
+	r.store.putMatch(match)
+	return nil
+}
+
+func (r *matchRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if match := r.store.match(id); match != nil {
+		r.store.softDelete(&match.BaseEntity)
+		r.store.matches[id] = *match
+	}
+	return nil
+}
+
+func (r *matchRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Match, int64, error) {
+	return r.findPage(nil, latestFirst, false, page, limit)
+}
+
+func (r *matchRepositoryImpl) FindByDateRange(ctx context.Context, startDate, endDate time.Time, page, limit int) ([]entity.Match, int64, error) {
+	return r.findPage(func(match *entity.Match) bool {
+		return between(match.MatchDate, &startDate, &endDate)
+	}, earliestFirst, false, page, limit)
+}
+
+func (r *matchRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
+	return r.findPage(involves(teamID), latestFirst, false, page, limit)
+}
+
+func (r *matchRepositoryImpl) FindByStatus(ctx context.Context, status entity.MatchStatus, page, limit int) ([]entity.Match, int64, error) {
+	return r.findPage(func(match *entity.Match) bool {
+		return match.Status == status
+	}, earliestFirst, false, page, limit)
+}
+
+func (r *matchRepositoryImpl) FindBySeasonID(ctx context.Context, seasonID uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
+	return r.findPage(inSeason(&seasonID), earliestFirst, false, page, limit)
+}
+
+func (r *matchRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.match(id) != nil, nil
+}
+
+func (r *matchRepositoryImpl) CountWinsByTeams(ctx context.Context, teamIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	wins := make(map[uuid.UUID]int64, len(teamIDs))
+	for _, match := range live(r.store.matches, matchBase, completed(nil), nil) {
+		var winner uuid.UUID
+		switch match.GetResult() {
+		case entity.ResultHomeWin:
+			winner = match.HomeTeamID
+		case entity.ResultAwayWin:
+			winner = match.AwayTeamID
+		default:
+			continue
+		}
+		if slices.Contains(teamIDs, winner) {
+			wins[winner]++
+		}
+	}
+	return wins, nil
+}
+
+func (r *matchRepositoryImpl) GetCompletedMatches(ctx context.Context, seasonID *uuid.UUID, page, limit int) ([]entity.Match, int64, error) {
+	return r.findPage(completed(seasonID), latestFirst, true, page, limit)
+}
+
+func (r *matchRepositoryImpl) FindAllCompleted(ctx context.Context, seasonID *uuid.UUID) ([]entity.Match, error) {
+	return r.find(completed(seasonID), earliestFirstByID, false)
+}
+
+func (r *matchRepositoryImpl) FindByGroupID(ctx context.Context, groupID uuid.UUID) ([]entity.Match, error) {
+	return r.find(func(match *entity.Match) bool {
+		return match.GroupID != nil && *match.GroupID == groupID
+	}, earliestFirst, false)
+}
+
+// FindPlayedByPlayer returns the completed matches the player scored in.
+// Lineups and substitutions are not held in memory, so appearances without a
+// goal are not found.
+func (r *matchRepositoryImpl) FindPlayedByPlayer(ctx context.Context, playerID uuid.UUID) ([]entity.Match, error) {
+	r.store.mu.RLock()
+	scored := make(map[uuid.UUID]bool)
+	for _, goal := range live(r.store.goals, goalBase, nil, nil) {
+		if goal.PlayerID == playerID {
+			scored[goal.MatchID] = true
+		}
+	}
+	r.store.mu.RUnlock()
+
+	isCompleted := completed(nil)
+	return r.find(func(match *entity.Match) bool {
+		return isCompleted(match) && scored[match.ID]
+	}, earliestFirst, true)
+}
+
+func (r *matchRepositoryImpl) FindCompletedByTeam(ctx context.Context, teamID uuid.UUID, from, to *time.Time) ([]entity.Match, error) {
+	isCompleted, plays := completed(nil), involves(teamID)
+	return r.find(func(match *entity.Match) bool {
+		return isCompleted(match) && plays(match) && between(match.MatchDate, from, to)
+	}, earliestFirst, false)
+}
+
+func (r *matchRepositoryImpl) FindCompletedBetweenTeams(ctx context.Context, teamA, teamB uuid.UUID) ([]entity.Match, error) {
+	isCompleted := completed(nil)
+	return r.find(func(match *entity.Match) bool {
+		meet := (match.HomeTeamID == teamA && match.AwayTeamID == teamB) ||
+			(match.HomeTeamID == teamB && match.AwayTeamID == teamA)
+		return isCompleted(match) && meet
+	}, latestFirst, true)
+}
+
+func (r *matchRepositoryImpl) FindForScheduling(ctx context.Context, from, to time.Time, teamIDs []uuid.UUID, venueID *uuid.UUID) ([]entity.Match, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return live(r.store.matches, matchBase, func(match *entity.Match) bool {
+		clashes := slices.Contains(teamIDs, match.HomeTeamID) || slices.Contains(teamIDs, match.AwayTeamID) ||
+			(venueID != nil && match.VenueID != nil && *match.VenueID == *venueID)
+		return clashes && match.Status != entity.MatchStatusCancelled && between(match.MatchDate, &from, &to)
+	}, earliestFirst), nil
+}
+
+func (r *matchRepositoryImpl) CountCompletedByTeamBetween(ctx context.Context, teamID uuid.UUID, after, before *entity.Match) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	isCompleted, plays := completed(nil), involves(teamID)
+	matches := live(r.store.matches, matchBase, func(match *entity.Match) bool {
+		return isCompleted(match) && plays(match) &&
+			after.KicksOffBefore(match) && (before == nil || match.KicksOffBefore(before))
+	}, nil)
+	return int64(len(matches)), nil
+}
+
+// findPage returns a page of the matches that keep accepts with their teams
+// and, optionally, their goals
+func (r *matchRepositoryImpl) findPage(keep func(*entity.Match) bool, order func(a, b *entity.Match) int, withGoals bool, page, limit int) ([]entity.Match, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := live(r.store.matches, matchBase, keep, order)
+	result := paginate(matches, page, limit)
+	r.store.loadTeams(result)
+	if withGoals {
+		r.store.loadGoals(result)
+	}
+	return result, int64(len(matches)), nil
+}
+
+// find returns the matches that keep accepts with their teams and,
+// optionally, their goals
+func (r *matchRepositoryImpl) find(keep func(*entity.Match) bool, order func(a, b *entity.Match) int, withGoals bool) ([]entity.Match, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	matches := live(r.store.matches, matchBase, keep, order)
+	r.store.loadTeams(matches)
+	if withGoals {
+		r.store.loadGoals(matches)
+	}
+	return matches, nil
+}
+
+// earliestFirst orders matches by match_date ASC, match_time ASC
+func earliestFirst(a, b *entity.Match) int {
+	if c := a.MatchDate.Compare(b.MatchDate); c != 0 {
+		return c
+	}
+	return strings.Compare(a.MatchTime, b.MatchTime)
+}
+
+// earliestFirstByID orders matches by match_date ASC, match_time ASC, id ASC
+func earliestFirstByID(a, b *entity.Match) int {
+	if c := earliestFirst(a, b); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.ID[:], b.ID[:])
+}
+
+// latestFirst orders matches by match_date DESC, match_time DESC
+func latestFirst(a, b *entity.Match) int {
+	return earliestFirst(b, a)
+}
+
+// involves accepts the matches the team plays, home or away
+func involves(teamID uuid.UUID) func(*entity.Match) bool {
+	return func(match *entity.Match) bool {
+		return match.HomeTeamID == teamID || match.AwayTeamID == teamID
+	}
+}
+
+// inSeason accepts the matches of the season
+func inSeason(seasonID *uuid.UUID) func(*entity.Match) bool {
+	return func(match *entity.Match) bool {
+		return match.SeasonID != nil && *match.SeasonID == *seasonID
+	}
+}
+
+// completed accepts the completed matches, optionally within a season
+func completed(seasonID *uuid.UUID) func(*entity.Match) bool {
+	return func(match *entity.Match) bool {
+		return match.Status == entity.MatchStatusCompleted && (seasonID == nil || inSeason(seasonID)(match))
+	}
+}
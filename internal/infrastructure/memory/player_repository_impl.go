@@ -0,0 +1,181 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type playerRepositoryImpl struct {
+	store *Store
+}
+
+// NewPlayerRepository creates a new instance of PlayerRepository
+func NewPlayerRepository(store *Store) repository.PlayerRepository {
+	return &playerRepositoryImpl{store: store}
+}
+
+func playerBase(player *entity.Player) *entity.BaseEntity { return &player.BaseEntity }
+
+// player returns a copy of the player, or nil when it is missing or deleted.
+// The caller holds the lock.
+func (s *Store) player(id uuid.UUID) *entity.Player {
+	player, ok := s.players[id]
+	if !ok || player.DeletedAt.Valid {
+		return nil
+	}
+	return &player
+}
+
+// putPlayer stores the player without its associations
+func (s *Store) putPlayer(player *entity.Player) {
+	record := *player
+	record.Team = nil
+	s.players[player.ID] = record
+}
+
+func (r *playerRepositoryImpl) Create(ctx context.Context, player *entity.Player) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.create(&player.BaseEntity)
+	r.store.putPlayer(player)
+	return nil
+}
+
+func (r *playerRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	player := r.store.player(id)
+	if player == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return player, nil
+}
+
+func (r *playerRepositoryImpl) FindByIDWithTeam(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	player := r.store.player(id)
+	if player == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	player.Team = r.store.team(player.TeamID)
+	return player, nil
+}
+
+func (r *playerRepositoryImpl) Update(ctx context.Context, player *entity.Player) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	player.UpdatedAt = r.store.now()
+	r.store.putPlayer(player)
+	return nil
+}
+
+func (r *playerRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if player := r.store.player(id); player != nil {
+		r.store.softDelete(&player.BaseEntity)
+		r.store.players[id] = *player
+	}
+	return nil
+}
+
+func (r *playerRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Player, int64, error) {
+	return r.find(nil, newestPlayerFirst, true, page, limit)
+}
+
+func (r *playerRepositoryImpl) FindByTeamID(ctx context.Context, teamID uuid.UUID, page, limit int) ([]entity.Player, int64, error) {
+	return r.find(func(player *entity.Player) bool {
+		return player.TeamID == teamID
+	}, byJerseyNumber, false, page, limit)
+}
+
+// FindAvailableByTeamID returns the team's players. Availability records are
+// not held in memory, so every player is available.
+func (r *playerRepositoryImpl) FindAvailableByTeamID(ctx context.Context, teamID uuid.UUID, date time.Time, page, limit int) ([]entity.Player, int64, error) {
+	return r.FindByTeamID(ctx, teamID, page, limit)
+}
+
+func (r *playerRepositoryImpl) IsJerseyNumberTaken(ctx context.Context, teamID uuid.UUID, jerseyNumber int, excludePlayerID *uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	holders := live(r.store.players, playerBase, func(player *entity.Player) bool {
+		return player.TeamID == teamID && player.JerseyNumber == jerseyNumber &&
+			(excludePlayerID == nil || player.ID != *excludePlayerID)
+	}, nil)
+	return len(holders) > 0, nil
+}
+
+func (r *playerRepositoryImpl) Search(ctx context.Context, query string, page, limit int) ([]entity.Player, int64, error) {
+	return r.find(func(player *entity.Player) bool {
+		return containsFold(player.Name, query)
+	}, newestPlayerFirst, true, page, limit)
+}
+
+func (r *playerRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.player(id) != nil, nil
+}
+
+func (r *playerRepositoryImpl) GetTopScorers(ctx context.Context, limit int) ([]repository.PlayerGoalCount, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	counts := make(map[uuid.UUID]int64)
+	for _, goal := range live(r.store.goals, goalBase, nil, nil) {
+		if r.store.player(goal.PlayerID) != nil {
+			counts[goal.PlayerID]++
+		}
+	}
+
+	results := make([]repository.PlayerGoalCount, 0, len(counts))
+	for id, count := range counts {
+		results = append(results, repository.PlayerGoalCount{Player: *r.store.player(id), GoalCount: count})
+	}
+	slices.SortFunc(results, func(a, b repository.PlayerGoalCount) int {
+		if c := cmp.Compare(b.GoalCount, a.GoalCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Player.ID.String(), b.Player.ID.String())
+	})
+	return paginate(results, 1, limit), nil
+}
+
+func newestPlayerFirst(a, b *entity.Player) int {
+	return newestFirst(&a.BaseEntity, &b.BaseEntity)
+}
+
+func byJerseyNumber(a, b *entity.Player) int {
+	return cmp.Compare(a.JerseyNumber, b.JerseyNumber)
+}
+
+// find returns a page of the players that keep accepts, optionally with
+// their teams
+func (r *playerRepositoryImpl) find(keep func(*entity.Player) bool, order func(a, b *entity.Player) int, withTeam bool, page, limit int) ([]entity.Player, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	players := live(r.store.players, playerBase, keep, order)
+	result := paginate(players, page, limit)
+	if withTeam {
+		for i := range result {
+			result[i].Team = r.store.team(result[i].TeamID)
+		}
+	}
+	return result, int64(len(players)), nil
+}
@@ -0,0 +1,21 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository/repositorytest"
+	"github.com/zenkriztao/ayo-football-backend/internal/infrastructure/memory"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		store := memory.NewStore()
+		return repositorytest.Repositories{
+			Users:   memory.NewUserRepository(store),
+			Teams:   memory.NewTeamRepository(store),
+			Players: memory.NewPlayerRepository(store),
+			Matches: memory.NewMatchRepository(store),
+			Goals:   memory.NewGoalRepository(store),
+		}
+	})
+}
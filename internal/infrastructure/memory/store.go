@@ -0,0 +1,170 @@
+// Package memory implements the repositories in process memory, for tests and
+// for running without a database. The repositories share a Store and mirror
+// the GORM implementations: soft-deleted records are hidden, pages are sorted
+// the same way and a missing record is reported as gorm.ErrRecordNotFound.
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// Store holds the records of the in-memory repositories. Records are kept
+// without their associations, which are loaded on every read.
+type Store struct {
+	mu      sync.RWMutex
+	now     func() time.Time
+	users   map[uuid.UUID]entity.User
+	teams   map[uuid.UUID]entity.Team
+	players map[uuid.UUID]entity.Player
+	matches map[uuid.UUID]entity.Match
+	goals   map[uuid.UUID]entity.Goal
+}
+
+// NewStore creates an empty Store
+func NewStore() *Store {
+	return &Store{
+		now:     time.Now,
+		users:   make(map[uuid.UUID]entity.User),
+		teams:   make(map[uuid.UUID]entity.Team),
+		players: make(map[uuid.UUID]entity.Player),
+		matches: make(map[uuid.UUID]entity.Match),
+		goals:   make(map[uuid.UUID]entity.Goal),
+	}
+}
+
+// storeData is a copy of the records, restored when a transaction fails
+type storeData struct {
+	users   map[uuid.UUID]entity.User
+	teams   map[uuid.UUID]entity.Team
+	players map[uuid.UUID]entity.Player
+	matches map[uuid.UUID]entity.Match
+	goals   map[uuid.UUID]entity.Goal
+}
+
+func (s *Store) snapshot() storeData {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return storeData{
+		users:   maps.Clone(s.users),
+		teams:   maps.Clone(s.teams),
+		players: maps.Clone(s.players),
+		matches: maps.Clone(s.matches),
+		goals:   maps.Clone(s.goals),
+	}
+}
+
+func (s *Store) restore(data storeData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users = data.users
+	s.teams = data.teams
+	s.players = data.players
+	s.matches = data.matches
+	s.goals = data.goals
+}
+
+// create prepares a new record's BaseEntity the way GORM does on insert
+func (s *Store) create(base *entity.BaseEntity) {
+	if base.ID == uuid.Nil {
+		base.ID = uuid.New()
+	}
+	now := s.now()
+	if base.CreatedAt.IsZero() {
+		base.CreatedAt = now
+	}
+	if base.UpdatedAt.IsZero() {
+		base.UpdatedAt = now
+	}
+}
+
+// softDelete marks a record deleted the way GORM does
+func (s *Store) softDelete(base *entity.BaseEntity) {
+	base.DeletedAt = gorm.DeletedAt{Time: s.now(), Valid: true}
+}
+
+// txKey is the context key marking a running transaction
+type txKey struct{}
+
+type transactorImpl struct {
+	store *Store
+}
+
+// NewTransactor creates a new instance of Transactor. A failed unit of work
+// is rolled back, but it is not isolated from other writers while it runs.
+func NewTransactor(store *Store) repository.Transactor {
+	return &transactorImpl{store: store}
+}
+
+func (t *transactorImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested units of work join the outer transaction
+	if ctx.Value(txKey{}) != nil {
+		return fn(ctx)
+	}
+
+	data := t.store.snapshot()
+	if err := fn(context.WithValue(ctx, txKey{}, true)); err != nil {
+		t.store.restore(data)
+		return err
+	}
+	return nil
+}
+
+// live returns the records that are not soft-deleted, sorted by cmp. Records
+// that compare equal keep the order of their IDs, so pages are stable.
+func live[T any](records map[uuid.UUID]T, base func(*T) *entity.BaseEntity, keep func(*T) bool, cmp func(a, b *T) int) []T {
+	var result []T
+	for _, record := range records {
+		if base(&record).DeletedAt.Valid || (keep != nil && !keep(&record)) {
+			continue
+		}
+		result = append(result, record)
+	}
+	slices.SortFunc(result, func(a, b T) int {
+		if cmp != nil {
+			if c := cmp(&a, &b); c != 0 {
+				return c
+			}
+		}
+		return strings.Compare(base(&a).ID.String(), base(&b).ID.String())
+	})
+	return result
+}
+
+// paginate returns a page of records like OFFSET and LIMIT would
+func paginate[T any](records []T, page, limit int) []T {
+	offset := max((page-1)*limit, 0)
+	if offset >= len(records) {
+		return nil
+	}
+	records = records[offset:]
+	if limit >= 0 && limit < len(records) {
+		records = records[:limit]
+	}
+	return records
+}
+
+// newestFirst orders records by created_at DESC
+func newestFirst(a, b *entity.BaseEntity) int {
+	return b.CreatedAt.Compare(a.CreatedAt)
+}
+
+// containsFold reports whether s contains substr, ignoring case like the
+// repositories' search
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// between reports whether t is within the optional bounds, inclusive
+func between(t time.Time, from, to *time.Time) bool {
+	return (from == nil || !t.Before(*from)) && (to == nil || !t.After(*to))
+}
@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type teamRepositoryImpl struct {
+	store *Store
+}
+
+// NewTeamRepository creates a new instance of TeamRepository
+func NewTeamRepository(store *Store) repository.TeamRepository {
+	return &teamRepositoryImpl{store: store}
+}
+
+func teamBase(team *entity.Team) *entity.BaseEntity { return &team.BaseEntity }
+
+// team returns a copy of the team, or nil when it is missing or deleted. The
+// caller holds the lock.
+func (s *Store) team(id uuid.UUID) *entity.Team {
+	team, ok := s.teams[id]
+	if !ok || team.DeletedAt.Valid {
+		return nil
+	}
+	return &team
+}
+
+// putTeam stores the team without its associations
+func (s *Store) putTeam(team *entity.Team) {
+	record := *team
+	record.HomeVenue = nil
+	record.Players = nil
+	s.teams[team.ID] = record
+}
+
+func (r *teamRepositoryImpl) Create(ctx context.Context, team *entity.Team) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.create(&team.BaseEntity)
+	r.store.putTeam(team)
+	return nil
+}
+
+func (r *teamRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	team := r.store.team(id)
+	if team == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return team, nil
+}
+
+func (r *teamRepositoryImpl) FindByIDWithPlayers(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	team := r.store.team(id)
+	if team == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	team.Players = live(r.store.players, playerBase, func(player *entity.Player) bool {
+		return player.TeamID == id
+	}, func(a, b *entity.Player) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return team, nil
+}
+
+func (r *teamRepositoryImpl) Update(ctx context.Context, team *entity.Team) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	team.UpdatedAt = r.store.now()
+	r.store.putTeam(team)
+	return nil
+}
+
+func (r *teamRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if team := r.store.team(id); team != nil {
+		r.store.softDelete(&team.BaseEntity)
+		r.store.teams[id] = *team
+	}
+	return nil
+}
+
+func (r *teamRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.Team, int64, error) {
+	return r.find(nil, page, limit)
+}
+
+func (r *teamRepositoryImpl) Search(ctx context.Context, query string, page, limit int) ([]entity.Team, int64, error) {
+	return r.find(func(team *entity.Team) bool {
+		return containsFold(team.Name, query) || containsFold(team.City, query)
+	}, page, limit)
+}
+
+func (r *teamRepositoryImpl) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.team(id) != nil, nil
+}
+
+// find returns a page of the teams that keep accepts, newest first
+func (r *teamRepositoryImpl) find(keep func(*entity.Team) bool, page, limit int) ([]entity.Team, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	teams := live(r.store.teams, teamBase, keep, func(a, b *entity.Team) int {
+		return newestFirst(&a.BaseEntity, &b.BaseEntity)
+	})
+	return paginate(teams, page, limit), int64(len(teams)), nil
+}
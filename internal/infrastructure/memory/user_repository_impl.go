@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/entity"
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type userRepositoryImpl struct {
+	store *Store
+}
+
+// NewUserRepository creates a new instance of UserRepository
+func NewUserRepository(store *Store) repository.UserRepository {
+	return &userRepositoryImpl{store: store}
+}
+
+func userBase(user *entity.User) *entity.BaseEntity { return &user.BaseEntity }
+
+func (r *userRepositoryImpl) Create(ctx context.Context, user *entity.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// The email index is unique across deleted users too
+	for _, existing := range r.store.users {
+		if existing.Email == user.Email {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	r.store.create(&user.BaseEntity)
+	r.store.users[user.ID] = *user
+	return nil
+}
+
+func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	user, ok := r.store.users[id]
+	if !ok || user.DeletedAt.Valid {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &user, nil
+}
+
+func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	users := live(r.store.users, userBase, func(user *entity.User) bool { return user.Email == email }, nil)
+	if len(users) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &users[0], nil
+}
+
+func (r *userRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, existing := range r.store.users {
+		if id != user.ID && existing.Email == user.Email {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	user.UpdatedAt = r.store.now()
+	r.store.users[user.ID] = *user
+	return nil
+}
+
+func (r *userRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if user, ok := r.store.users[id]; ok && !user.DeletedAt.Valid {
+		r.store.softDelete(&user.BaseEntity)
+		r.store.users[id] = user
+	}
+	return nil
+}
+
+func (r *userRepositoryImpl) FindAll(ctx context.Context, page, limit int) ([]entity.User, int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	users := live(r.store.users, userBase, nil, func(a, b *entity.User) int {
+		return newestFirst(&a.BaseEntity, &b.BaseEntity)
+	})
+	return paginate(users, page, limit), int64(len(users)), nil
+}
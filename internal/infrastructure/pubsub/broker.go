@@ -0,0 +1,14 @@
+package pubsub
+
+import "github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+
+// Broker publishes messages to topic subscribers, implementing the domain's
+// Publisher and Subscriber. The in-memory broker only reaches subscribers in
+// the same process; a Redis or NATS backed implementation can replace it to
+// fan out across instances.
+type Broker interface {
+	repository.Publisher
+	repository.Subscriber
+	// Close stops the broker and closes every subscription
+	Close() error
+}
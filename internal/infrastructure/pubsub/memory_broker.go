@@ -0,0 +1,106 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/zenkriztao/ayo-football-backend/internal/domain/repository"
+)
+
+// subscriberBuffer is how many messages a subscriber may fall behind by
+// before further messages to it are dropped
+const subscriberBuffer = 64
+
+// ErrBrokerClosed is returned when using a broker after Close
+var ErrBrokerClosed = errors.New("broker is closed")
+
+type memoryBroker struct {
+	mu     sync.RWMutex
+	topics map[string]map[chan repository.Message]struct{}
+	closed bool
+}
+
+// NewMemoryBroker creates an in-process Broker. Slow subscribers never block
+// publishers; messages they cannot keep up with are dropped.
+func NewMemoryBroker() Broker {
+	return &memoryBroker{topics: make(map[string]map[chan repository.Message]struct{})}
+}
+
+func (b *memoryBroker) Publish(ctx context.Context, topic string, data []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return ErrBrokerClosed
+	}
+
+	msg := repository.Message{Topic: topic, Data: data}
+	for ch := range b.topics[topic] {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+	return nil
+}
+
+func (b *memoryBroker) Subscribe(ctx context.Context, topic string) (<-chan repository.Message, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrBrokerClosed
+	}
+
+	ch := make(chan repository.Message, subscriberBuffer)
+	if b.topics[topic] == nil {
+		b.topics[topic] = make(map[chan repository.Message]struct{})
+	}
+	b.topics[topic][ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(topic, ch)
+	}()
+
+	return ch, nil
+}
+
+func (b *memoryBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+
+	for topic, subscribers := range b.topics {
+		for ch := range subscribers {
+			close(ch)
+		}
+		delete(b.topics, topic)
+	}
+	return nil
+}
+
+// unsubscribe removes and closes a subscription unless Close already did
+func (b *memoryBroker) unsubscribe(topic string, ch chan repository.Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subscribers, ok := b.topics[topic]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[ch]; !ok {
+		return
+	}
+
+	delete(subscribers, ch)
+	close(ch)
+	if len(subscribers) == 0 {
+		delete(b.topics, topic)
+	}
+}
@@ -10,7 +10,7 @@ type Response struct {
 	Message string      `json:"message,omitempty"`
 	Data    interface{} `json:"data,omitempty"`
 	Error   interface{} `json:"error,omitempty"`
-	Meta    *Meta       `json:"meta,omitempty"`
+	Meta    interface{} `json:"meta,omitempty"`
 }
 
 // Meta represents pagination metadata
@@ -30,8 +30,9 @@ func Success(c *gin.Context, statusCode int, message string, data interface{}) {
 	})
 }
 
-// SuccessWithMeta sends a success response with pagination metadata
-func SuccessWithMeta(c *gin.Context, statusCode int, message string, data interface{}, meta *Meta) {
+// SuccessWithMeta sends a success response with metadata, usually a *Meta or
+// a struct embedding one
+func SuccessWithMeta(c *gin.Context, statusCode int, message string, data interface{}, meta interface{}) {
 	c.JSON(statusCode, Response{
 		Success: true,
 		Message: message,